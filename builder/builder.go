@@ -56,6 +56,14 @@ type Generator interface {
 	ReturnError(ctx *MethodContext,
 		path ErrorPath,
 		id *jen.Statement) (jen.Code, bool)
+
+	// CanReturnError returns true, if ReturnError would succeed for the current method.
+	CanReturnError(ctx *MethodContext) bool
+
+	// ContextParam returns the context parameter with the given type, it's
+	// added to the generated methods if required. It returns false if the
+	// context isn't available.
+	ContextParam(ctx *MethodContext, typ string) (*jen.Statement, bool)
 }
 
 // MethodContext exposes information for the current method.
@@ -76,6 +84,11 @@ type MethodContext struct {
 	AvailableContext map[string]*xtype.Type
 
 	TargetVar *jen.Statement
+
+	// Report collects the field assignments of struct conversions, can be nil.
+	Report *MethodReport
+	// Usage tracks the settings with an effect, can be nil.
+	Usage *SettingUsage
 }
 
 func (ctx *MethodContext) HasSeen(source *xtype.Type) bool {
@@ -95,6 +108,13 @@ func (ctx *MethodContext) MarkSeen(source *xtype.Type) {
 	ctx.SeenNamed[typeString] = struct{}{}
 }
 
+func (ctx *MethodContext) UnmarkSeen(source *xtype.Type) {
+	if !source.Named {
+		return
+	}
+	delete(ctx.SeenNamed, source.NamedType.String())
+}
+
 func (ctx *MethodContext) SetErrorTargetVar(m *jen.Statement) {
 	if ctx.TargetVar == nil {
 		ctx.TargetVar = m
@@ -117,6 +137,9 @@ func (ctx *MethodContext) DefinedFields(target *xtype.Type) map[string]struct{}
 	if ctx.FieldsTarget != target.String {
 		return emptyFields
 	}
+	if ctx.Usage != nil {
+		ctx.Usage.Fields = true
+	}
 
 	f := map[string]struct{}{}
 	for name := range ctx.Conf.Fields {
@@ -125,6 +148,13 @@ func (ctx *MethodContext) DefinedFields(target *xtype.Type) map[string]struct{}
 	return f
 }
 
+func (ctx *MethodContext) DefinedIgnorePatterns(target *xtype.Type) []*config.FieldPattern {
+	if ctx.FieldsTarget != target.String {
+		return nil
+	}
+	return ctx.Conf.IgnoreMatching
+}
+
 func (ctx *MethodContext) DefinedEnumFields(target *xtype.Type) map[string]struct{} {
 	if ctx.FieldsTarget != target.String {
 		return emptyFields
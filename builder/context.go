@@ -0,0 +1,31 @@
+package builder
+
+import (
+	"fmt"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/jmattheis/goverter/xtype"
+)
+
+// contextCheck returns the statement returning the error of the
+// context.Context every ctx:checkEvery iterations, it's nil if the setting is
+// disabled.
+func contextCheck(gen Generator, ctx *MethodContext, counter string, path ErrorPath) (jen.Code, *Error) {
+	every := ctx.Conf.CtxCheckEvery
+	if every <= 0 {
+		return nil, nil
+	}
+
+	param, ok := gen.ContextParam(ctx, xtype.ContextType)
+	if !ok {
+		return nil, NewError(fmt.Sprintf("goverter:ctx:checkEvery requires a %s parameter on the conversion method.", xtype.ContextType))
+	}
+	ret, ok := gen.ReturnError(ctx, path, jen.Id("err"))
+	if !ok {
+		return nil, NewError("goverter:ctx:checkEvery requires that the conversion method returns an error.")
+	}
+
+	return jen.If(jen.Id(counter).Op("%").Lit(every).Op("==").Lit(0)).Block(
+		jen.If(jen.Id("err").Op(":=").Add(param).Dot("Err").Call(), jen.Id("err").Op("!=").Nil()).Block(ret),
+	), nil
+}
@@ -1,9 +1,13 @@
 package builder
 
 import (
+	"fmt"
+	"go/constant"
 	"go/types"
+	"math"
 
 	"github.com/dave/jennifer/jen"
+	"github.com/jmattheis/goverter/config"
 	"github.com/jmattheis/goverter/xtype"
 )
 
@@ -44,3 +48,196 @@ func buildTargetVar(gen Generator, ctx *MethodContext, sourceID *xtype.JenID, so
 	ctx.SetErrorTargetVar(jen.Id(name))
 	return stmt, jen.Id(name), nil
 }
+
+func assignWithDefault(gen Generator, ctx *MethodContext, assignTo *AssignTo, sourceID *xtype.JenID, source, target *xtype.Type, def *config.DefaultValue, errPath ErrorPath) ([]jen.Code, *Error) {
+	var condition *jen.Statement
+	addCondition := func(stmt *jen.Statement) {
+		if condition == nil {
+			condition = stmt
+		} else {
+			condition = condition.Op("&&").Add(stmt)
+		}
+	}
+
+	valueID, value := sourceID, source
+	if source.Pointer {
+		addCondition(sourceID.Code.Clone().Op("!=").Nil())
+		valueID, value = sourceID.Deref(source), source.PointerInner
+	}
+	if ctx.Conf.DefaultValueOnZero {
+		if zero := comparableZeroValue(value); zero != nil {
+			addCondition(valueID.Code.Clone().Op("!=").Add(zero))
+		}
+	}
+
+	if condition == nil {
+		return gen.Assign(ctx, assignTo, sourceID, source, target, errPath)
+	}
+
+	var valueStmt []jen.Code
+	var err *Error
+	if source.Pointer && target.Pointer {
+		var id *xtype.JenID
+		valueStmt, id, err = gen.Build(ctx, valueID, value, target.PointerInner, errPath)
+		if err == nil {
+			pstmt, pointerID := id.Pointer(target.PointerInner, ctx.Name)
+			valueStmt = append(valueStmt, pstmt...)
+			valueStmt = append(valueStmt, assignTo.Stmt.Clone().Op("=").Add(pointerID.Code))
+		}
+	} else {
+		valueStmt, err = gen.Assign(ctx, assignTo, valueID, value, target, errPath)
+	}
+	if err != nil {
+		if source.Pointer {
+			err = err.Lift(&Path{SourceID: "*", SourceType: value.String})
+		}
+		return nil, err
+	}
+
+	defaultStmt, err := assignDefault(gen, ctx, assignTo, def, target, errPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return []jen.Code{jen.If(condition).Block(valueStmt...).Else().Block(defaultStmt...)}, nil
+}
+
+func assignDefault(gen Generator, ctx *MethodContext, assignTo *AssignTo, def *config.DefaultValue, target *xtype.Type, errPath ErrorPath) ([]jen.Code, *Error) {
+	stmt, id, err := buildDefault(gen, ctx, def, target, errPath)
+	if err != nil {
+		return nil, err
+	}
+	return append(stmt, assignTo.Stmt.Clone().Op("=").Add(id.Code)), nil
+}
+
+func buildDefault(gen Generator, ctx *MethodContext, def *config.DefaultValue, target *xtype.Type, errPath ErrorPath) ([]jen.Code, *xtype.JenID, *Error) {
+	if def.Function != nil {
+		callTarget := target
+		toPointer := target.Pointer &&
+			!def.Function.Target.AssignableTo(target) &&
+			def.Function.Target.AssignableTo(target.PointerInner)
+		if toPointer {
+			callTarget = target.PointerInner
+		}
+
+		stmt, id, err := gen.CallMethod(ctx, def.Function, nil, nil, callTarget, errPath)
+		if err != nil {
+			return nil, nil, err
+		}
+		if toPointer {
+			pstmt, pointerID := id.Pointer(callTarget, ctx.Name)
+			stmt = append(stmt, pstmt...)
+			id = pointerID
+		}
+		return stmt, id, nil
+	}
+
+	var code *jen.Statement
+	if def.Const != nil {
+		code = jen.Qual(def.Const.Pkg().Path(), def.Const.Name())
+	} else {
+		code = jen.Op(def.Literal)
+	}
+
+	assignable := func(t *xtype.Type) bool {
+		if def.Value != nil {
+			return literalAssignable(def.Value, def.Type, t.T)
+		}
+		return types.AssignableTo(def.Type, t.T)
+	}
+
+	switch {
+	case assignable(target):
+		return nil, xtype.OtherID(code), nil
+	case target.Pointer && assignable(target.PointerInner):
+		name := ctx.Name(target.PointerInner.ID())
+		stmt := []jen.Code{jen.Var().Id(name).Add(target.PointerInner.TypeAsJen()).Op("=").Add(code)}
+		return stmt, xtype.OtherID(jen.Op("&").Id(name)), nil
+	default:
+		return nil, nil, NewError(fmt.Sprintf("Cannot use default value %s of type %s as %s", def.Raw, def.Type, target.String))
+	}
+}
+
+// literalAssignable returns true, if the untyped constant value can be
+// assigned to t without overflow or truncation.
+func literalAssignable(value constant.Value, typ, t types.Type) bool {
+	basic, ok := t.Underlying().(*types.Basic)
+	if !ok {
+		return types.AssignableTo(typ, t)
+	}
+
+	info := basic.Info()
+	switch {
+	case info&types.IsInteger != 0:
+		value = constant.ToInt(value)
+		if value.Kind() != constant.Int {
+			return false
+		}
+		return integerFits(value, basic.Kind())
+	case info&types.IsFloat != 0:
+		value = constant.ToFloat(value)
+		if value.Kind() != constant.Float {
+			return false
+		}
+		if basic.Kind() == types.Float32 {
+			f, _ := constant.Float32Val(value)
+			return !math.IsInf(float64(f), 0)
+		}
+		f, _ := constant.Float64Val(value)
+		return !math.IsInf(f, 0)
+	case info&types.IsComplex != 0:
+		return constant.ToComplex(value).Kind() == constant.Complex
+	case info&types.IsString != 0:
+		return value.Kind() == constant.String
+	case info&types.IsBoolean != 0:
+		return value.Kind() == constant.Bool
+	default:
+		return false
+	}
+}
+
+func integerFits(value constant.Value, kind types.BasicKind) bool {
+	switch kind {
+	case types.Int8:
+		return fitsSigned(value, math.MinInt8, math.MaxInt8)
+	case types.Int16:
+		return fitsSigned(value, math.MinInt16, math.MaxInt16)
+	case types.Int32:
+		return fitsSigned(value, math.MinInt32, math.MaxInt32)
+	case types.Int, types.Int64:
+		return fitsSigned(value, math.MinInt64, math.MaxInt64)
+	case types.Uint8:
+		return fitsUnsigned(value, math.MaxUint8)
+	case types.Uint16:
+		return fitsUnsigned(value, math.MaxUint16)
+	case types.Uint32:
+		return fitsUnsigned(value, math.MaxUint32)
+	default:
+		return fitsUnsigned(value, math.MaxUint64)
+	}
+}
+
+func fitsSigned(value constant.Value, lower, upper int64) bool {
+	v, exact := constant.Int64Val(value)
+	return exact && v >= lower && v <= upper
+}
+
+func fitsUnsigned(value constant.Value, upper uint64) bool {
+	v, exact := constant.Uint64Val(value)
+	return exact && v <= upper
+}
+
+// comparableZeroValue returns the zero value of t, if the check against it
+// is always valid go code.
+func comparableZeroValue(t *xtype.Type) *jen.Statement {
+	switch {
+	case t.Basic:
+		return xtype.ZeroValue(t.T)
+	case t.Pointer, t.Map, t.Chan, t.Interface, t.Signature, t.List && !t.ListFixed:
+		return jen.Nil()
+	case (t.Struct || t.ListFixed) && types.Comparable(t.T):
+		return xtype.ZeroValue(t.T)
+	default:
+		return nil
+	}
+}
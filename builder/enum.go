@@ -6,6 +6,7 @@ import (
 	"github.com/dave/jennifer/jen"
 	"github.com/jmattheis/goverter/config"
 	"github.com/jmattheis/goverter/enum"
+	"github.com/jmattheis/goverter/suggest"
 	"github.com/jmattheis/goverter/xtype"
 )
 
@@ -42,6 +43,11 @@ func (*Enum) Build(gen Generator, ctx *MethodContext, sourceID *xtype.JenID, sou
 		return nil, nil, err
 	}
 
+	report := &EnumReport{Source: source.String, Target: target.String}
+	if ctx.Usage != nil {
+		ctx.Usage.Enum = true
+	}
+
 	sourceTargetMapping := map[interface{}]enumMapping{}
 	for _, sourceName := range sourceEnum.SortedMembers() {
 		delete(definedKeys, sourceName)
@@ -54,6 +60,7 @@ func (*Enum) Build(gen Generator, ctx *MethodContext, sourceID *xtype.JenID, sou
 		if !ok {
 			targetName = sourceName
 		}
+		report.Values = append(report.Values, &EnumValueReport{Source: sourceName, Target: targetName})
 
 		sourceQual := jen.Qual(source.NamedType.Obj().Pkg().Path(), sourceName)
 		body, err := caseAction(gen, ctx, nameVar, target, targetEnum, targetName, sourceID, path)
@@ -103,9 +110,10 @@ func (*Enum) Build(gen Generator, ctx *MethodContext, sourceID *xtype.JenID, sou
 		})
 	}
 	cases = append(cases, jen.Default().Add(body))
+	report.Unknown = enumUnknown
 
 	for name := range definedKeys {
-		return nil, nil, NewError(fmt.Sprintf("Configured enum value %s does not exist on\n    %s", name, source.String)).
+		return nil, nil, NewError(fmt.Sprintf("Configured enum value %s does not exist on\n    %s%s", name, source.String, enumSuggestions(name, sourceEnum))).
 			Lift(&Path{
 				Prefix:     ".",
 				SourceID:   name,
@@ -113,6 +121,7 @@ func (*Enum) Build(gen Generator, ctx *MethodContext, sourceID *xtype.JenID, sou
 			})
 	}
 
+	ctx.reportEnum(path, report)
 	stmt = append(stmt, jen.Switch(sourceID.Code).Block(cases...))
 	return stmt, xtype.VariableID(nameVar), nil
 }
@@ -141,13 +150,20 @@ func caseAction(gen Generator, ctx *MethodContext, nameVar *jen.Statement, targe
 	}
 	_, ok := targetEnum.Members[targetName]
 	if !ok {
-		return nil, NewError(fmt.Sprintf("Enum %s does not exist on\n    %s\n\nSee https://goverter.jmattheis.de/guide/enum", targetName, target.String))
+		return nil, NewError(fmt.Sprintf("Enum %s does not exist on\n    %s%s\n\nSee https://goverter.jmattheis.de/guide/enum", targetName, target.String, enumSuggestions(targetName, targetEnum)))
 	}
 
 	targetQual := jen.Qual(target.NamedType.Obj().Pkg().Path(), targetName)
 	return nameVar.Clone().Op("=").Add(targetQual), nil
 }
 
+func enumSuggestions(name string, e *xtype.Enum) string {
+	if hint := suggest.Hint(suggest.Similar(name, e.SortedMembers())); hint != "" {
+		return "\n\n" + hint
+	}
+	return ""
+}
+
 func executeTransformers(transformers []config.ConfiguredTransformer, source, target *xtype.Type, sourceEnum, targetEnum *xtype.Enum) (map[string]string, *Error) {
 	transformerMapping := map[string]string{}
 	for _, t := range transformers {
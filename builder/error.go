@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"math"
 	"strings"
+
+	"github.com/jmattheis/goverter/diagnostic"
 )
 
 // Path defines the path inside an error message.
@@ -20,6 +22,8 @@ type Path struct {
 type Error struct {
 	Path  []*Path
 	Cause string
+	// Fix is a setting resolving the error, can be nil.
+	Fix *diagnostic.Fix
 }
 
 // NewError creates an error.
@@ -33,6 +33,48 @@ func (e ErrorPath) WrapErrorsUsing(pkg string, errStmt *jen.Statement) *jen.Stat
 	return jen.Qual(pkg, "Wrap").Call(args...)
 }
 
+// Format returns a fmt format string and its arguments describing the path,
+// e.g. "Items[%d].ID".
+func (e ErrorPath) Format() (string, []jen.Code) {
+	format := ""
+	args := []jen.Code{}
+	for _, elm := range e {
+		switch elm := elm.(type) {
+		case errElmField:
+			if format != "" {
+				format += "."
+			}
+			format += string(elm)
+		case errElmIndex:
+			format += "[%d]"
+			args = append(args, elm.stmt.Clone())
+		case errElmKey:
+			format += "[%v]"
+			args = append(args, elm.stmt.Clone())
+		}
+	}
+	return format, args
+}
+
+// String returns a readable representation of the path, e.g. "Items[].ID".
+func (e ErrorPath) String() string {
+	s := ""
+	for _, elm := range e {
+		switch elm := elm.(type) {
+		case errElmField:
+			if s != "" {
+				s += "."
+			}
+			s += string(elm)
+		case errElmIndex:
+			s += "[]"
+		case errElmKey:
+			s += "[key]"
+		}
+	}
+	return s
+}
+
 func (e ErrorPath) Index(code *jen.Statement) ErrorPath { return append(e, errElmIndex{code}) }
 func (e ErrorPath) Key(code *jen.Statement) ErrorPath   { return append(e, errElmKey{code}) }
 func (e ErrorPath) Field(name string) ErrorPath         { return append(e, errElmField(name)) }
@@ -53,14 +53,29 @@ func (*List) Assign(gen Generator, ctx *MethodContext, assignTo *AssignTo, sourc
 		})
 	}
 
+	check, err := contextCheck(gen, ctx, index, path)
+	if err != nil {
+		return nil, err
+	}
+	if check != nil {
+		forBlock = append([]jen.Code{check}, forBlock...)
+	}
+
 	var result []jen.Code
 
 	if !target.ListFixed {
 		result = append(result, assignTo.Stmt.Clone().Op("=").Make(target.TypeAsJen(), jen.Len(sourceID.Code.Clone())))
 	}
 
-	forStmt := jen.For(jen.Id(index).Op(":=").Lit(0), jen.Id(index).Op("<").Len(sourceID.Code.Clone()), jen.Id(index).Op("++")).
+	var forStmt jen.Code = jen.For(jen.Id(index).Op(":=").Lit(0), jen.Id(index).Op("<").Len(sourceID.Code.Clone()), jen.Id(index).Op("++")).
 		Block(forBlock...)
+	// arrays are values, the error handling of the workers would copy the
+	// array while other workers write to it.
+	if threshold := ctx.Conf.ListParallel; threshold > 0 && !target.ListFixed {
+		forStmt = jen.If(jen.Len(sourceID.Code.Clone()).Op(">=").Lit(threshold)).
+			Block(parallelFor(ctx, sourceID, index, forBlock)...).
+			Else().Block(forStmt)
+	}
 	result = append(result, forStmt)
 
 	if source.ListFixed {
@@ -69,3 +84,79 @@ func (*List) Assign(gen Generator, ctx *MethodContext, assignTo *AssignTo, sourc
 
 	return []jen.Code{jen.If(sourceID.Code.Clone().Op("!=").Nil()).Block(result...)}, nil
 }
+
+// parallelFor returns the statements running forBlock for all indexes of the
+// source in chunks across runtime.GOMAXPROCS goroutines. If the method returns
+// an error, forBlock runs in a function with the signature of the method and
+// the error of the first failing chunk is returned. Panics of the goroutines
+// are recovered and raised again after all goroutines are done.
+func parallelFor(ctx *MethodContext, sourceID *xtype.JenID, index string, forBlock []jen.Code) []jen.Code {
+	workers := ctx.Name("workers")
+	chunk := ctx.Name("chunk")
+	wg := ctx.Name("wg")
+	worker := ctx.Name("worker")
+	start := ctx.Name("start")
+	end := ctx.Name("end")
+	errs := ctx.Name("errs")
+	panics := ctx.Name("panics")
+	p := ctx.Name("p")
+	length := jen.Len(sourceID.Code.Clone())
+
+	loop := jen.For(jen.Id(index).Op(":=").Id(start), jen.Id(index).Op("<").Id(end), jen.Id(index).Op("++")).Block(forBlock...)
+
+	stmt := []jen.Code{
+		jen.Id(workers).Op(":=").Qual("runtime", "GOMAXPROCS").Call(jen.Lit(0)),
+		jen.Id(chunk).Op(":=").Parens(length.Clone().Op("+").Id(workers).Op("-").Lit(1)).Op("/").Id(workers),
+		jen.Id(panics).Op(":=").Make(jen.Index().Any(), jen.Id(workers)),
+	}
+
+	work := []jen.Code{
+		jen.Defer().Id(wg).Dot("Done").Call(),
+		jen.Defer().Func().Params().Block(jen.Id(panics).Index(jen.Id(worker)).Op("=").Recover()).Call(),
+	}
+	if ctx.Conf.ReturnError {
+		// the error returns of forBlock copy the target. This is safe, because
+		// arrays are converted sequentially, so the workers only write into
+		// the elements of the slice and not into the copied value.
+		var results, assign []jen.Code
+		if !ctx.Conf.UpdateTarget {
+			results = append(results, jen.Id("_").Add(ctx.Conf.Target.TypeAsJen()))
+			assign = append(assign, jen.Id("_"))
+		}
+		results = append(results, jen.Id("_").Error())
+		assign = append(assign, jen.Id(errs).Index(jen.Id(worker)))
+
+		stmt = append(stmt, jen.Id(errs).Op(":=").Make(jen.Index().Error(), jen.Id(workers)))
+		work = append(work, jen.List(assign...).Op("=").Func().Params().Params(results...).Block(loop, jen.Return()).Call())
+	} else {
+		work = append(work, loop)
+	}
+
+	stmt = append(stmt,
+		jen.Var().Id(wg).Qual("sync", "WaitGroup"),
+		jen.For(jen.Id(worker).Op(":=").Lit(0), jen.Id(worker).Op("*").Id(chunk).Op("<").Add(length.Clone()), jen.Id(worker).Op("++")).Block(
+			jen.Id(start).Op(":=").Id(worker).Op("*").Id(chunk),
+			jen.Id(end).Op(":=").Id(start).Op("+").Id(chunk),
+			jen.If(jen.Id(end).Op(">").Add(length.Clone())).Block(jen.Id(end).Op("=").Add(length.Clone())),
+			jen.Id(wg).Dot("Add").Call(jen.Lit(1)),
+			jen.Go().Func().Params(jen.List(jen.Id(worker), jen.Id(start), jen.Id(end)).Int()).Block(work...).
+				Call(jen.Id(worker), jen.Id(start), jen.Id(end)),
+		),
+		jen.Id(wg).Dot("Wait").Call(),
+		jen.For(jen.List(jen.Id("_"), jen.Id(p)).Op(":=").Range().Id(panics)).Block(
+			jen.If(jen.Id(p).Op("!=").Nil()).Block(jen.Panic(jen.Id(p))),
+		),
+	)
+
+	if ctx.Conf.ReturnError {
+		var returns []jen.Code
+		if !ctx.Conf.UpdateTarget {
+			returns = append(returns, ctx.TargetVar.Clone())
+		}
+		returns = append(returns, jen.Err())
+		stmt = append(stmt, jen.For(jen.List(jen.Id("_"), jen.Err()).Op(":=").Range().Id(errs)).Block(
+			jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(returns...)),
+		))
+	}
+	return stmt
+}
@@ -23,6 +23,15 @@ func (*Map) Assign(gen Generator, ctx *MethodContext, assignTo *AssignTo, source
 	ctx.SetErrorTargetVar(jen.Nil())
 	key, value := ctx.Map()
 
+	var counter string
+	if ctx.Conf.CtxCheckEvery > 0 {
+		counter = ctx.Index()
+	}
+	check, err := contextCheck(gen, ctx, counter, errPath)
+	if err != nil {
+		return nil, err
+	}
+
 	errPath = errPath.Key(jen.Id(key))
 
 	block, keyID, err := gen.Build(ctx, xtype.VariableID(jen.Id(key)), source.MapKey, target.MapKey, errPath)
@@ -46,13 +55,14 @@ func (*Map) Assign(gen Generator, ctx *MethodContext, assignTo *AssignTo, source
 	}
 	block = append(block, valueStmt...)
 
-	stmt := []jen.Code{
-		jen.If(sourceID.Code.Clone().Op("!=").Nil()).Block(
-			assignTo.Stmt.Clone().Op("=").Make(target.TypeAsJen(), jen.Len(sourceID.Code.Clone())),
-			jen.For(jen.List(jen.Id(key), jen.Id(value)).Op(":=").Range().Add(sourceID.Code)).
-				Block(block...),
-		),
+	body := []jen.Code{assignTo.Stmt.Clone().Op("=").Make(target.TypeAsJen(), jen.Len(sourceID.Code.Clone()))}
+	if check != nil {
+		block = append([]jen.Code{check, jen.Id(counter).Op("++")}, block...)
+		body = append(body, jen.Id(counter).Op(":=").Lit(0))
 	}
+	body = append(body, jen.For(jen.List(jen.Id(key), jen.Id(value)).Op(":=").Range().Add(sourceID.Code)).Block(block...))
+
+	stmt := []jen.Code{jen.If(sourceID.Code.Clone().Op("!=").Nil()).Block(body...)}
 
 	return stmt, nil
 }
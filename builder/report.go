@@ -0,0 +1,101 @@
+package builder
+
+import (
+	"strings"
+
+	"github.com/jmattheis/goverter/config"
+	"github.com/jmattheis/goverter/method"
+)
+
+// Reasons why a target field wasn't assigned.
+const (
+	SkipIgnored    = "ignored"
+	SkipPattern    = "ignored by pattern"
+	SkipMissing    = "missing"
+	SkipUnexported = "unexported"
+)
+
+// MethodReport describes how the target fields of a method are assigned.
+type MethodReport struct {
+	Name   string         `json:"name"`
+	Source string         `json:"source,omitempty"`
+	Target string         `json:"target"`
+	Fields []*FieldReport `json:"fields"`
+	Enums  []*EnumReport  `json:"enums,omitempty"`
+}
+
+// FieldReport describes how a single target field is assigned.
+type FieldReport struct {
+	Target string `json:"target"`
+	// Source is the resolved source path of the field.
+	Source string `json:"source,omitempty"`
+	// Function is the custom function used to create the value.
+	Function string `json:"function,omitempty"`
+	// Default is the value of default:value.
+	Default string `json:"default,omitempty"`
+	// SkipZero is set, when the field isn't updated for zero source values.
+	SkipZero bool `json:"skipZero,omitempty"`
+	// Required is set, when the field is checked against its zero value.
+	Required bool `json:"required,omitempty"`
+	// Skipped is the reason why the field isn't assigned.
+	Skipped string `json:"skipped,omitempty"`
+}
+
+// EnumReport describes the mapping of enum values.
+type EnumReport struct {
+	// Path is the target path of the enum conversion inside the method.
+	Path    string             `json:"path,omitempty"`
+	Source  string             `json:"source"`
+	Target  string             `json:"target"`
+	Values  []*EnumValueReport `json:"values"`
+	Unknown string             `json:"unknown"`
+}
+
+// EnumValueReport describes the target of a single enum value. Target is
+// either an enum member or an action like @ignore.
+type EnumValueReport struct {
+	Source string `json:"source"`
+	Target string `json:"target"`
+}
+
+func (ctx *MethodContext) reportEnum(errPath ErrorPath, enum *EnumReport) {
+	if ctx.Report == nil {
+		return
+	}
+	enum.Path, _ = errPath.Format()
+	ctx.Report.Enums = append(ctx.Report.Enums, enum)
+}
+
+func (ctx *MethodContext) reportField(errPath ErrorPath, field *FieldReport) {
+	if ctx.Report == nil {
+		return
+	}
+	field.Target, _ = errPath.Format()
+	ctx.Report.Fields = append(ctx.Report.Fields, field)
+}
+
+func reportSource(mapping *config.FieldMapping, lift []*Path) string {
+	if mapping.Source == "." {
+		return "."
+	}
+	var b strings.Builder
+	for _, p := range lift {
+		if p.Prefix == "(" {
+			b.WriteString("()")
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteString(".")
+		}
+		b.WriteString(p.SourceID)
+	}
+	return b.String()
+}
+
+// FunctionName returns the package qualified name of the function.
+func FunctionName(def *method.Definition) string {
+	if def.Package == "" {
+		return def.Name
+	}
+	return def.Package + "." + def.Name
+}
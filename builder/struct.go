@@ -7,7 +7,9 @@ import (
 
 	"github.com/dave/jennifer/jen"
 	"github.com/jmattheis/goverter/config"
+	"github.com/jmattheis/goverter/diagnostic"
 	"github.com/jmattheis/goverter/method"
+	"github.com/jmattheis/goverter/suggest"
 	"github.com/jmattheis/goverter/xtype"
 )
 
@@ -37,17 +39,48 @@ func (s *Struct) Assign(gen Generator, ctx *MethodContext, assignTo *AssignTo, s
 	stmt := []jen.Code{}
 
 	definedFields := ctx.DefinedFields(target)
+	definedPatterns := ctx.DefinedIgnorePatterns(target)
 	usedSourceID := false
+	requiredStmt := []jen.Code{}
 	for i := 0; i < target.StructType.NumFields(); i++ {
 		targetField := target.StructType.Field(i)
 		delete(definedFields, targetField.Name())
 
 		fieldMapping := ctx.Field(target, targetField.Name())
+		targetFieldType := xtype.TypeOf(targetField.Type())
+		targetFieldPath := errPath.Field(targetField.Name())
+
+		report := &FieldReport{}
+		ctx.reportField(targetFieldPath, report)
+
+		if isRequired(ctx, fieldMapping, targetField) {
+			report.Required = true
+			checkStmt, err := checkRequired(gen, ctx, assignTo.Stmt.Clone().Dot(targetField.Name()), xtype.TypeOf(targetField.Type()), errPath.Field(targetField.Name()))
+			if err != nil {
+				return nil, err.Lift(&Path{
+					Prefix:     ".",
+					TargetID:   targetField.Name(),
+					TargetType: targetField.Type().String(),
+				})
+			}
+			requiredStmt = append(requiredStmt, checkStmt...)
+		}
+
+		// match before checking the mapping, a pattern matching only
+		// explicitly configured fields is still used.
+		matchedDefined := matchPatterns(definedPatterns, targetField.Name())
+		matchedPattern := matchPatterns(ctx.Conf.IgnorePatterns, targetField.Name())
 
 		if fieldMapping.Ignore {
+			report.Skipped = SkipIgnored
+			continue
+		}
+		if !fieldMapping.Mapped() && (matchedPattern || matchedDefined) {
+			report.Skipped = SkipPattern
 			continue
 		}
 		if !targetField.Exported() && ctx.Conf.IgnoreUnexported {
+			report.Skipped = SkipUnexported
 			continue
 		}
 
@@ -61,31 +94,57 @@ func (s *Struct) Assign(gen Generator, ctx *MethodContext, assignTo *AssignTo, s
 			})
 		}
 
-		targetFieldType := xtype.TypeOf(targetField.Type())
-		targetFieldPath := errPath.Field(targetField.Name())
+		if fieldMapping.Default != nil {
+			report.Default = fieldMapping.Default.Raw
+		}
 
 		if fieldMapping.Function == nil {
 			usedSourceID = true
 			nextID, nextSource, mapStmt, lift, skip, err := mapField(gen, ctx, targetField, sourceID, source, target, additionalFieldSources, targetFieldPath)
 			if skip {
+				if fieldMapping.Default == nil {
+					report.Skipped = SkipMissing
+				} else {
+					defaultStmt, err := assignDefault(gen, ctx, AssignOf(assignTo.Stmt.Clone().Dot(targetField.Name())), fieldMapping.Default, targetFieldType, targetFieldPath)
+					if err != nil {
+						return nil, err.Lift(defaultValuePath(targetField, fieldMapping.Default))
+					}
+					stmt = append(stmt, defaultStmt...)
+				}
 				continue
 			}
 			if err != nil {
 				return nil, err
 			}
 			stmt = append(stmt, mapStmt...)
+			report.Source = reportSource(fieldMapping, lift)
+
+			if fieldMapping.Default != nil {
+				fieldStmt, err := assignWithDefault(gen, ctx, AssignOf(assignTo.Stmt.Clone().Dot(targetField.Name())), nextID, nextSource, targetFieldType, fieldMapping.Default, targetFieldPath)
+				if err != nil {
+					return nil, err.Lift(lift...)
+				}
+				stmt = append(stmt, fieldStmt...)
+				continue
+			}
 
 			fieldStmt, err := gen.Assign(ctx, AssignOf(assignTo.Stmt.Clone().Dot(targetField.Name())), nextID, nextSource, targetFieldType, targetFieldPath)
 			if err != nil {
 				return nil, err.Lift(lift...)
 			}
 			if shouldCheckAgainstZero(ctx, nextSource, targetFieldType, assignTo.Update, false) {
+				report.SkipZero = true
 				stmt = append(stmt, jen.If(nextID.Code.Clone().Op("!=").Add(xtype.ZeroValue(nextSource.T))).Block(fieldStmt...))
 			} else {
 				stmt = append(stmt, fieldStmt...)
 			}
 		} else {
 			def := fieldMapping.Function
+			report.Function = FunctionName(def)
+
+			if fieldMapping.Default != nil {
+				return nil, NewError(fmt.Sprintf("Cannot combine default:value with the map function %s.\nHandle the fallback inside the function or remove the default:value setting.", FunctionName(def))).Lift(defaultValuePath(targetField, fieldMapping.Default))
+			}
 
 			sourceLift := []*Path{}
 			var functionCallSourceID *xtype.JenID
@@ -97,6 +156,7 @@ func (s *Struct) Assign(gen Generator, ctx *MethodContext, assignTo *AssignTo, s
 					return nil, err
 				}
 				sourceLift = mapLift
+				report.Source = reportSource(fieldMapping, mapLift)
 				stmt = append(stmt, mapStmt...)
 
 				if fieldMapping.Source == "." && sourceID.ParentPointer != nil &&
@@ -122,6 +182,7 @@ func (s *Struct) Assign(gen Generator, ctx *MethodContext, assignTo *AssignTo, s
 			callStmt = append(callStmt, assignTo.Stmt.Clone().Dot(targetField.Name()).Op("=").Add(callReturnID.Code))
 
 			if shouldCheckAgainstZero(ctx, functionCallSourceType, targetFieldType, assignTo.Update, true) {
+				report.SkipZero = true
 				stmt = append(stmt, jen.If(functionCallSourceID.Code.Clone().Op("!=").Add(xtype.ZeroValue(functionCallSourceType.T))).Block(callStmt...))
 			} else {
 				stmt = append(stmt, callStmt...)
@@ -131,6 +192,7 @@ func (s *Struct) Assign(gen Generator, ctx *MethodContext, assignTo *AssignTo, s
 	if !usedSourceID {
 		stmt = append(stmt, jen.Id("_").Op("=").Add(sourceID.Code.Clone()))
 	}
+	stmt = append(stmt, requiredStmt...)
 
 	for name := range definedFields {
 		return nil, NewError(fmt.Sprintf("Field %q does not exist.\nRemove or adjust field settings referencing this field.", name)).Lift(&Path{
@@ -140,6 +202,16 @@ func (s *Struct) Assign(gen Generator, ctx *MethodContext, assignTo *AssignTo, s
 		})
 	}
 
+	for _, pattern := range definedPatterns {
+		if !pattern.Used {
+			return nil, NewError(fmt.Sprintf("Pattern %s does not match any field.\nRemove or adjust field settings referencing this pattern.", pattern.Raw)).Lift(&Path{
+				Prefix:     ".",
+				TargetID:   pattern.Raw,
+				TargetType: "???",
+			})
+		}
+	}
+
 	return stmt, nil
 }
 
@@ -224,20 +296,23 @@ func mapField(
 	if pathString == "" {
 		sourceMatch, err := xtype.FindField(targetField.Name(), ctx.Conf.MatchIgnoreCase, source, additionalFieldSources)
 		if err != nil {
-			cause := fmt.Sprintf("Cannot match the target field with the source entry: %s.", err.Error())
+			cause := withSuggestions(fmt.Sprintf("Cannot match the target field with the source entry: %s.", err.Error()), err)
 			skip := false
-			if ctx.Conf.IgnoreMissing {
+			if ctx.Conf.IgnoreMissing || def.Default != nil {
 				_, skip = err.(*xtype.NoMatchError)
 			}
-			return nil, nil, nil, nil, skip, NewError(cause).Lift(&Path{
+			buildErr := NewError(cause).Lift(&Path{
 				Prefix:     ".",
 				SourceID:   "???",
 				TargetID:   targetField.Name(),
 				TargetType: targetField.Type().String(),
 			})
+			buildErr.Fix = missingFieldFix(ctx, target, targetField.Name(), err)
+			return nil, nil, nil, nil, skip, buildErr
 		}
 
 		path = sourceMatch.Path
+		ctx.markAutoMapUsed(path)
 	} else {
 		path = strings.Split(pathString, ".")
 	}
@@ -283,7 +358,7 @@ func mapField(
 			continue
 		}
 
-		cause := fmt.Sprintf("Cannot find the mapped field on the source entry: %s.", err.Error())
+		cause := withSuggestions(fmt.Sprintf("Cannot find the mapped field on the source entry: %s.", err.Error()), err)
 		return nil, nil, []jen.Code{}, nil, false, NewError(cause).Lift(&Path{
 			Prefix:     ".",
 			SourceID:   path[i],
@@ -352,6 +427,34 @@ func mapField(
 	return returnID, nextSource, stmt, lift, false, nil
 }
 
+// withSuggestions appends the similar field names of a xtype.NoMatchError to
+// the cause.
+func withSuggestions(cause string, err error) string {
+	if noMatch, ok := err.(*xtype.NoMatchError); ok && len(noMatch.Suggestions) > 0 {
+		return cause + "\n\n" + suggest.Hint(noMatch.Suggestions)
+	}
+	return cause
+}
+
+// missingFieldFix returns the setting resolving a missing source field of a
+// target field defined on the method. It's nil if the method isn't declared by
+// the user or if multiple source fields are similar.
+func missingFieldFix(ctx *MethodContext, target *xtype.Type, targetName string, err error) *diagnostic.Fix {
+	noMatch, ok := err.(*xtype.NoMatchError)
+	if !ok || ctx.Conf.Location == "" || ctx.FieldsTarget != target.String {
+		return nil
+	}
+
+	switch len(noMatch.Suggestions) {
+	case 0:
+		return &diagnostic.Fix{Location: ctx.Conf.Location, Setting: "ignore " + targetName}
+	case 1:
+		return &diagnostic.Fix{Location: ctx.Conf.Location, Setting: fmt.Sprintf("map %s %s", noMatch.Suggestions[0], targetName)}
+	default:
+		return nil
+	}
+}
+
 func parseAutoMap(ctx *MethodContext, source *xtype.Type) ([]xtype.FieldSources, *Error) {
 	fieldSources := []xtype.FieldSources{}
 	for _, field := range ctx.Conf.AutoMap {
@@ -361,7 +464,7 @@ func parseAutoMap(ctx *MethodContext, source *xtype.Type) ([]xtype.FieldSources,
 		for _, part := range path {
 			field, err := xtype.FindExactField(innerSource, part)
 			if err != nil {
-				return nil, NewError(err.Error()).Lift(&Path{
+				return nil, NewError(withSuggestions(err.Error(), err)).Lift(&Path{
 					Prefix:     ".",
 					SourceID:   part,
 					SourceType: "goverter:autoMap",
@@ -389,6 +492,74 @@ func parseAutoMap(ctx *MethodContext, source *xtype.Type) ([]xtype.FieldSources,
 	return fieldSources, nil
 }
 
+// matchPatterns returns true, if any pattern matches the name. All matching
+// patterns are marked as used.
+func matchPatterns(patterns []*config.FieldPattern, name string) bool {
+	matched := false
+	for _, pattern := range patterns {
+		if pattern.Matches(name) {
+			pattern.Used = true
+			matched = true
+		}
+	}
+	return matched
+}
+
+func isRequired(ctx *MethodContext, fieldMapping *config.FieldMapping, targetField *types.Var) bool {
+	if fieldMapping.Required {
+		return true
+	}
+	return ctx.Conf.RequireNonZero != nil &&
+		xtype.Accessible(targetField, ctx.OutputPackagePath) &&
+		ctx.Conf.RequireNonZero.MatchString(targetField.Name())
+}
+
+func checkRequired(gen Generator, ctx *MethodContext, fieldStmt *jen.Statement, fieldType *xtype.Type, errPath ErrorPath) ([]jen.Code, *Error) {
+	zero := comparableZeroValue(fieldType)
+	if zero == nil {
+		return nil, NewError(fmt.Sprintf("Cannot check required field, because %s cannot be compared against its zero value.", fieldType.String))
+	}
+
+	format, args := errPath.Format()
+	format = "required field " + format + " is zero"
+
+	var action jen.Code
+	if gen.CanReturnError(ctx) {
+		// The path is already part of the message, wrapping it with the same
+		// path again would duplicate it. Only wrapErrorsUsing keeps the path
+		// separate from the message.
+		wrapPath := ErrorPath{}
+		if ctx.Conf.WrapErrorsUsing != "" {
+			format, args = "required field is zero", nil
+			wrapPath = errPath
+		}
+
+		var errStmt *jen.Statement
+		if len(args) == 0 {
+			errStmt = jen.Qual("errors", "New").Call(jen.Lit(format))
+		} else {
+			errStmt = jen.Qual("fmt", "Errorf").Call(append([]jen.Code{jen.Lit(format)}, args...)...)
+		}
+		action, _ = gen.ReturnError(ctx, wrapPath, errStmt)
+	} else if len(args) == 0 {
+		action = jen.Panic(jen.Lit(format))
+	} else {
+		action = jen.Panic(jen.Qual("fmt", "Sprintf").Call(append([]jen.Code{jen.Lit(format)}, args...)...))
+	}
+
+	return []jen.Code{jen.If(fieldStmt.Op("==").Add(zero)).Block(action)}, nil
+}
+
+func defaultValuePath(targetField *types.Var, def *config.DefaultValue) *Path {
+	return &Path{
+		Prefix:     ".",
+		SourceID:   " ",
+		SourceType: "goverter:default:value " + targetField.Name() + " " + def.Raw,
+		TargetID:   targetField.Name(),
+		TargetType: targetField.Type().String(),
+	}
+}
+
 func unexportedStructError(targetField, sourceType, targetType string) string {
 	return fmt.Sprintf(`Cannot set value for unexported field "%s".
 
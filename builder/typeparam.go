@@ -0,0 +1,44 @@
+package builder
+
+import (
+	"fmt"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/jmattheis/goverter/xtype"
+)
+
+// TypeParam handles conversions from and to type parameters of generic
+// converters.
+type TypeParam struct{}
+
+// Matches returns true, if the builder can create handle the given types.
+func (*TypeParam) Matches(_ *MethodContext, source, target *xtype.Type) bool {
+	return source.TypeParam || target.TypeParam
+}
+
+// Build creates conversion source code for the given source and target type.
+func (*TypeParam) Build(_ Generator, _ *MethodContext, sourceID *xtype.JenID, source, target *xtype.Type, _ ErrorPath) ([]jen.Code, *xtype.JenID, *Error) {
+	if !source.AssignableTo(target) {
+		return nil, nil, typeParamMismatch(source, target)
+	}
+	return nil, sourceID, nil
+}
+
+func (*TypeParam) Assign(_ Generator, _ *MethodContext, assignTo *AssignTo, sourceID *xtype.JenID, source, target *xtype.Type, _ ErrorPath) ([]jen.Code, *Error) {
+	if !source.AssignableTo(target) {
+		return nil, typeParamMismatch(source, target)
+	}
+	return []jen.Code{assignTo.Stmt.Clone().Op("=").Add(sourceID.Code)}, nil
+}
+
+func typeParamMismatch(source, target *xtype.Type) *Error {
+	return NewError(fmt.Sprintf(`TypeMismatch: Cannot convert %s to %s
+
+The conversion depends on the type argument, which is unknown when generating
+the converter. Add a context parameter with the function type
+
+    func(%s) %s
+
+to the method, or define a custom conversion method with extend:
+https://goverter.jmattheis.de/reference/extend`, source.String, target.String, source.String, target.String))
+}
@@ -0,0 +1,39 @@
+package builder
+
+import (
+	"strings"
+
+	"github.com/jmattheis/goverter/xtype"
+)
+
+// SettingUsage tracks which settings of a method had an effect on the
+// generated code.
+type SettingUsage struct {
+	// Fields is set when the struct of the field settings was converted.
+	Fields bool
+	// Enum is set when an enum conversion happened.
+	Enum bool
+	// AutoMap contains the autoMap paths that didn't provide any field.
+	AutoMap xtype.UsageChecker
+}
+
+// NewSettingUsage creates a SettingUsage for the given autoMap paths.
+func NewSettingUsage(autoMap []string) *SettingUsage {
+	usage := &SettingUsage{AutoMap: xtype.UsageChecker{}}
+	for _, path := range autoMap {
+		usage.AutoMap[path] = struct{}{}
+	}
+	return usage
+}
+
+func (ctx *MethodContext) markAutoMapUsed(path []string) {
+	if ctx.Usage == nil {
+		return
+	}
+	joined := strings.Join(path, ".")
+	for _, autoMap := range ctx.Conf.AutoMap {
+		if strings.HasPrefix(joined, autoMap+".") {
+			ctx.Usage.AutoMap.Used(autoMap)
+		}
+	}
+}
@@ -1,6 +1,10 @@
 package cli
 
-import "github.com/jmattheis/goverter"
+import (
+	"github.com/jmattheis/goverter"
+	"github.com/jmattheis/goverter/diagnostic"
+	"github.com/jmattheis/goverter/scaffold"
+)
 
 type Command interface {
 	_c()
@@ -8,6 +12,27 @@ type Command interface {
 
 type Generate struct {
 	Config *goverter.GenerateConfig
+	Format diagnostic.Format
+}
+
+type Check struct {
+	Config *goverter.GenerateConfig
+	Format diagnostic.Format
+}
+
+type Explain struct {
+	Config *goverter.GenerateConfig
+	// Method is the explained method, e.g. Converter.Convert.
+	Method string
+}
+
+type List struct {
+	Config *goverter.GenerateConfig
+	JSON   bool
+}
+
+type Init struct {
+	Config *scaffold.Config
 }
 
 type Help struct {
@@ -18,4 +43,8 @@ type Version struct{}
 
 func (*Help) _c()     {}
 func (*Generate) _c() {}
+func (*Check) _c()    {}
+func (*Explain) _c()  {}
+func (*List) _c()     {}
+func (*Init) _c()     {}
 func (*Version) _c()  {}
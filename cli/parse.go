@@ -5,10 +5,13 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"os"
 
 	"github.com/jmattheis/goverter"
 	"github.com/jmattheis/goverter/config"
+	"github.com/jmattheis/goverter/diagnostic"
 	"github.com/jmattheis/goverter/enum"
+	"github.com/jmattheis/goverter/scaffold"
 )
 
 type Strings []string
@@ -46,7 +49,15 @@ func Parse(args []string) (Command, error) {
 
 	switch subArgs[0] {
 	case "gen":
-		return parseGen(cmd, subArgs[1:])
+		return parseGen(cmd, subArgs[1:], false)
+	case "check":
+		return parseGen(cmd, subArgs[1:], true)
+	case "explain":
+		return parseExplain(cmd, subArgs[1:])
+	case "list":
+		return parseList(cmd, subArgs[1:])
+	case "init":
+		return parseInit(cmd, subArgs[1:])
 	case "version":
 		return &Version{}, nil
 	case "help":
@@ -56,7 +67,7 @@ func Parse(args []string) (Command, error) {
 	}
 }
 
-func parseGen(cmd string, args []string) (Command, error) {
+func parseGen(cmd string, args []string, check bool) (Command, error) {
 	fs := flag.NewFlagSet(cmd, flag.ContinueOnError)
 	fs.SetOutput(io.Discard)
 	fs.Usage = func() {}
@@ -67,7 +78,25 @@ func parseGen(cmd string, args []string) (Command, error) {
 
 	buildTags := fs.String("build-tags", "goverter", "")
 	outputConstraint := fs.String("output-constraint", "!goverter", "")
+	header := fs.String("header", "", "")
 	cwd := fs.String("cwd", "", "")
+	format := fs.String("format", string(diagnostic.FormatText), "")
+	keepGoing := fs.Bool("keep-going", check, "")
+	strict := fs.Bool("strict", false, "")
+	report := new(string)
+	writePartial := new(bool)
+	fix := new(bool)
+	clean := new(bool)
+	dryRun := new(bool)
+	stdout := new(bool)
+	if !check {
+		report = fs.String("report", "", "")
+		writePartial = fs.Bool("write-partial", false, "")
+		fix = fs.Bool("fix", false, "")
+		clean = fs.Bool("clean", false, "")
+		dryRun = fs.Bool("dry-run", false, "")
+		stdout = fs.Bool("stdout", false, "")
+	}
 
 	if err := fs.Parse(args); err != nil {
 		if errors.Is(err, flag.ErrHelp) {
@@ -76,24 +105,176 @@ func parseGen(cmd string, args []string) (Command, error) {
 		return nil, usageErr(err.Error(), cmd)
 	}
 
+	switch diagnostic.Format(*format) {
+	case diagnostic.FormatText, diagnostic.FormatJSON, diagnostic.FormatSARIF:
+	default:
+		return nil, usageErr(fmt.Sprintf("invalid -format %q, expected text, json or sarif", *format), cmd)
+	}
+
+	switch {
+	case *fix && *dryRun:
+		return nil, usageErr("-fix cannot be used with -dry-run", cmd)
+	case *fix && *stdout:
+		return nil, usageErr("-fix cannot be used with -stdout", cmd)
+	case *stdout && diagnostic.Format(*format) != diagnostic.FormatText:
+		return nil, usageErr("-stdout cannot be used with -format "+*format, cmd)
+	}
+
 	patterns := fs.Args()
 
 	if len(patterns) == 0 {
 		return nil, usageErr("missing PATTERN", cmd)
 	}
 
+	var output io.Writer
+	if *stdout {
+		output = os.Stdout
+	}
+
 	c := goverter.GenerateConfig{
 		PackagePatterns:       patterns,
 		BuildTags:             *buildTags,
 		OutputBuildConstraint: *outputConstraint,
+		OutputHeader:          *header,
 		WorkingDir:            *cwd,
+		Report:                *report,
+		KeepGoing:             *keepGoing || *writePartial,
+		WritePartial:          *writePartial,
+		Strict:                *strict,
+		Fix:                   *fix,
+		Clean:                 *clean,
+		DryRun:                *dryRun,
+		Output:                output,
 		EnumTransformers:      map[string]enum.Transformer{},
 		Global: config.RawLines{
 			Lines:    global,
 			Location: "command line (-g, -global)",
 		},
 	}
-	return &Generate{Config: &c}, nil
+	if check {
+		return &Check{Config: &c, Format: diagnostic.Format(*format)}, nil
+	}
+	return &Generate{Config: &c, Format: diagnostic.Format(*format)}, nil
+}
+
+func parseExplain(cmd string, args []string) (Command, error) {
+	fs := flag.NewFlagSet(cmd, flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.Usage = func() {}
+
+	var global Strings
+	fs.Var(&global, "global", "")
+	fs.Var(&global, "g", "")
+
+	buildTags := fs.String("build-tags", "goverter", "")
+	cwd := fs.String("cwd", "", "")
+
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return &Help{Usage: usage(cmd)}, nil
+		}
+		return nil, usageErr(err.Error(), cmd)
+	}
+
+	switch fs.NArg() {
+	case 0:
+		return nil, usageErr("missing PACKAGE", cmd)
+	case 1:
+		return nil, usageErr("missing INTERFACE.METHOD", cmd)
+	case 2:
+	default:
+		return nil, usageErr("too many arguments", cmd)
+	}
+
+	c := goverter.GenerateConfig{
+		PackagePatterns:  []string{fs.Arg(0)},
+		BuildTags:        *buildTags,
+		WorkingDir:       *cwd,
+		EnumTransformers: map[string]enum.Transformer{},
+		Global: config.RawLines{
+			Lines:    global,
+			Location: "command line (-g, -global)",
+		},
+	}
+	return &Explain{Config: &c, Method: fs.Arg(1)}, nil
+}
+
+func parseList(cmd string, args []string) (Command, error) {
+	fs := flag.NewFlagSet(cmd, flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.Usage = func() {}
+
+	var global Strings
+	fs.Var(&global, "global", "")
+	fs.Var(&global, "g", "")
+
+	buildTags := fs.String("build-tags", "goverter", "")
+	cwd := fs.String("cwd", "", "")
+	format := fs.String("format", "text", "")
+
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return &Help{Usage: usage(cmd)}, nil
+		}
+		return nil, usageErr(err.Error(), cmd)
+	}
+
+	if *format != "text" && *format != "json" {
+		return nil, usageErr(fmt.Sprintf("invalid -format %q, expected text or json", *format), cmd)
+	}
+
+	patterns := fs.Args()
+	if len(patterns) == 0 {
+		return nil, usageErr("missing PATTERN", cmd)
+	}
+
+	c := goverter.GenerateConfig{
+		PackagePatterns:  patterns,
+		BuildTags:        *buildTags,
+		WorkingDir:       *cwd,
+		EnumTransformers: map[string]enum.Transformer{},
+		Global: config.RawLines{
+			Lines:    global,
+			Location: "command line (-g, -global)",
+		},
+	}
+	return &List{Config: &c, JSON: *format == "json"}, nil
+}
+
+func parseInit(cmd string, args []string) (Command, error) {
+	fs := flag.NewFlagSet(cmd, flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.Usage = func() {}
+
+	source := fs.String("source", "", "")
+	target := fs.String("target", "", "")
+	out := fs.String("out", ".", "")
+	buildTags := fs.String("build-tags", "goverter", "")
+	cwd := fs.String("cwd", "", "")
+
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return &Help{Usage: usage(cmd)}, nil
+		}
+		return nil, usageErr(err.Error(), cmd)
+	}
+
+	switch {
+	case *source == "":
+		return nil, usageErr("missing -source", cmd)
+	case *target == "":
+		return nil, usageErr("missing -target", cmd)
+	case fs.NArg() > 0:
+		return nil, usageErr("too many arguments", cmd)
+	}
+
+	return &Init{Config: &scaffold.Config{
+		Source:     *source,
+		Target:     *target,
+		OutputDir:  *out,
+		WorkingDir: *cwd,
+		BuildTags:  *buildTags,
+	}}, nil
 }
 
 func usageErr(err, cmd string) error {
@@ -103,9 +284,29 @@ func usageErr(err, cmd string) error {
 func usage(cmd string) string {
 	return fmt.Sprintf(`Usage:
   %s gen [OPTIONS] PACKAGE...
+  %s check [OPTIONS] PACKAGE...
+  %s explain [OPTIONS] PACKAGE INTERFACE.METHOD
+  %s list [OPTIONS] PACKAGE...
+  %s init -source TYPE -target TYPE [-out DIR]
   %s help
   %s version
 
+COMMANDS:
+  gen:     generate the converters
+  check:   validate the converters without writing files. Reports the errors
+           of all converters and methods.
+  explain: print how the conversion method is built: the used builders,
+           extend functions, generated methods, context and settings. Only
+           the options -build-tags, -cwd and -g are supported.
+  list:    print all converters with their output, methods and the helper
+           methods that will be generated. Only the options -build-tags,
+           -cwd, -g and -format text|json are supported.
+  init:    create a converter interface converting -source into -target in
+           the directory -out (default: .). TYPE is the package path and the
+           type name e.g. github.com/example/pkg.Input. Fields that cannot be
+           matched automatically are pre-filled with map or ignore settings.
+           Supports the options -build-tags and -cwd.
+
 PACKAGE(s):
   Define the import paths goverter will use to search for converter interfaces.
   You can define multiple packages and use the special ... golang pattern to
@@ -117,23 +318,80 @@ OPTIONS:
       during the loading of conversion interfaces. See 'go help buildconstraint'.
       Can be disabled by supplying an empty string.
 
+  -clean: (gen only)
+      delete files with the goverter generated header in the output
+      directories, that weren't generated in this run e.g. after renaming a
+      converter or changing output:file. The deleted files are printed.
+
   -cwd [value]:
       set the working directory
 
+  -dry-run: (gen only)
+      don't write or delete any files. The files that would be written are
+      printed with their status: new, changed or unchanged. With -clean, the
+      files that would be deleted are printed as "would delete".
+
+  -fix: (gen only)
+      add goverter:ignore and goverter:map settings to the method comments for
+      target fields without a matching source field. A map setting is added if
+      exactly one source field has a similar name, otherwise the field is
+      ignored. The added settings are printed.
+
+  -format [text|json|sarif]: (default: text)
+      the format of the diagnostics. json and sarif are printed to stdout, even
+      if the generation succeeded. For list, the format of the output.
+
+  -header [value]:
+      the default output:header of all converters: a comment or @file PATH.
+      The header is written above the generated code marker and supports
+      {{.Package}} and {{.Converter}}. Relative paths are resolved against the
+      working directory.
+
   -g [value], -global [value]:
       apply settings to all defined converters. For a list of available
       settings see: https://goverter.jmattheis.de/reference/settings
 
+  -keep-going: (default: false for gen, true for check)
+      collect the errors of all converters and methods instead of stopping at
+      the first one.
+
   -output-constraint [constraint]: (default: !goverter)
       A build constraint added to all files generated by goverter.
       Can be disabled by supplying an empty string.
 
+  -report [file]: (gen only)
+      write a report describing how each target field of the generated struct
+      conversions is assigned, including skipped fields. The format is inferred
+      from the file extension: .json or .md
+
+  -stdout: (gen only)
+      write the generated files to stdout instead of the file system. Each
+      file is preceded by a "// File: PATH" line. With -clean, the files that
+      would be deleted are printed as "would delete" but aren't deleted.
+
+  -strict:
+      turn warnings about settings without an effect into errors.
+
+  -write-partial: (gen only)
+      write the files of converters without errors, even if other converters
+      failed. Implies -keep-going.
+
 Examples:
   %s gen ./example/simple ./example/complex
   %s gen ./example/...
   %s gen github.com/jmattheis/goverter/example/simple
   %s gen -g 'ignoreMissing no' -g 'skipCopySameType' ./simple
+  %s gen -report mapping.md ./example/...
+  %s gen -fix ./example/...
+  %s gen -clean -dry-run ./example/...
+  %s gen -stdout ./example/simple
+  %s gen -format sarif ./example/... > goverter.sarif
+  %s check ./example/...
+  %s check -strict ./example/...
+  %s explain ./example/simple Converter.Convert
+  %s list -format json ./example/...
+  %s init -source example.com/db.User -target example.com/api.User -out ./convert
 
 Documentation:
-  Full documentation is available here: https://goverter.jmattheis.de`, cmd, cmd, cmd, cmd, cmd, cmd, cmd)
+  Full documentation is available here: https://goverter.jmattheis.de`, cmd, cmd, cmd, cmd, cmd, cmd, cmd, cmd, cmd, cmd, cmd, cmd, cmd, cmd, cmd, cmd, cmd, cmd, cmd, cmd, cmd)
 }
@@ -1,13 +1,16 @@
 package cli_test
 
 import (
+	"os"
 	"strings"
 	"testing"
 
 	"github.com/jmattheis/goverter"
 	"github.com/jmattheis/goverter/cli"
 	"github.com/jmattheis/goverter/config"
+	"github.com/jmattheis/goverter/diagnostic"
 	"github.com/jmattheis/goverter/enum"
+	"github.com/jmattheis/goverter/scaffold"
 	"github.com/stretchr/testify/require"
 )
 
@@ -24,6 +27,23 @@ func TestError(t *testing.T) {
 		{[]string{"goverter", "gen"}, "Error: missing PATTERN"},
 		{[]string{"goverter", "gen", "-u"}, "Error: flag provided but not defined: -u"},
 		{[]string{"goverter", "gen", "-g"}, "Error: flag needs an argument: -g"},
+		{[]string{"goverter", "gen", "-format", "xml", "pkg"}, `Error: invalid -format "xml"`},
+		{[]string{"goverter", "check"}, "Error: missing PATTERN"},
+		{[]string{"goverter", "check", "-report", "a.md", "pkg"}, "Error: flag provided but not defined: -report"},
+		{[]string{"goverter", "check", "-fix", "pkg"}, "Error: flag provided but not defined: -fix"},
+		{[]string{"goverter", "check", "-clean", "pkg"}, "Error: flag provided but not defined: -clean"},
+		{[]string{"goverter", "gen", "-fix", "-dry-run", "pkg"}, "Error: -fix cannot be used with -dry-run"},
+		{[]string{"goverter", "gen", "-fix", "-stdout", "pkg"}, "Error: -fix cannot be used with -stdout"},
+		{[]string{"goverter", "gen", "-stdout", "-format", "json", "pkg"}, "Error: -stdout cannot be used with -format json"},
+		{[]string{"goverter", "list"}, "Error: missing PATTERN"},
+		{[]string{"goverter", "list", "-format", "sarif", "pkg"}, `Error: invalid -format "sarif"`},
+		{[]string{"goverter", "explain"}, "Error: missing PACKAGE"},
+		{[]string{"goverter", "explain", "pkg"}, "Error: missing INTERFACE.METHOD"},
+		{[]string{"goverter", "explain", "pkg", "C.M", "other"}, "Error: too many arguments"},
+		{[]string{"goverter", "explain", "-format", "json", "pkg", "C.M"}, "Error: flag provided but not defined: -format"},
+		{[]string{"goverter", "init"}, "Error: missing -source"},
+		{[]string{"goverter", "init", "-source", "pkg.A"}, "Error: missing -target"},
+		{[]string{"goverter", "init", "-source", "pkg.A", "-target", "pkg.B", "other"}, "Error: too many arguments"},
 	}
 
 	for _, test := range tests {
@@ -67,6 +87,8 @@ func TestSuccess(t *testing.T) {
 		"-cwd", "file/path",
 		"-build-tags", "",
 		"-output-constraint", "",
+		"-report", "mapping.json",
+		"-format", "sarif",
 		"-g", "g1",
 		"-global", "g2",
 		"-g", "g3 oops",
@@ -77,6 +99,7 @@ func TestSuccess(t *testing.T) {
 	expected := &cli.Generate{&goverter.GenerateConfig{
 		PackagePatterns:       []string{"pattern1", "pattern2"},
 		WorkingDir:            "file/path",
+		Report:                "mapping.json",
 		OutputBuildConstraint: "",
 		BuildTags:             "",
 		EnumTransformers:      map[string]enum.Transformer{},
@@ -84,10 +107,120 @@ func TestSuccess(t *testing.T) {
 			Location: "command line (-g, -global)",
 			Lines:    []string{"g1", "g2", "g3 oops"},
 		},
-	}}
+	}, diagnostic.FormatSARIF}
 	require.Equal(t, expected, actual)
 }
 
+func TestKeepGoing(t *testing.T) {
+	actual, err := cli.Parse([]string{"goverter", "gen", "-write-partial", "pattern"})
+	require.NoError(t, err)
+	require.IsType(t, &cli.Generate{}, actual)
+	require.True(t, actual.(*cli.Generate).Config.KeepGoing)
+	require.True(t, actual.(*cli.Generate).Config.WritePartial)
+
+	actual, err = cli.Parse([]string{"goverter", "check", "pattern"})
+	require.NoError(t, err)
+	require.IsType(t, &cli.Check{}, actual)
+	require.True(t, actual.(*cli.Check).Config.KeepGoing)
+
+	actual, err = cli.Parse([]string{"goverter", "check", "-keep-going=false", "pattern"})
+	require.NoError(t, err)
+	require.False(t, actual.(*cli.Check).Config.KeepGoing)
+}
+
+func TestExplain(t *testing.T) {
+	actual, err := cli.Parse([]string{"goverter", "explain", "-cwd", "dir", "-g", "g1", "pkg", "Converter.Convert"})
+	require.NoError(t, err)
+
+	expected := &cli.Explain{
+		Method: "Converter.Convert",
+		Config: &goverter.GenerateConfig{
+			PackagePatterns:  []string{"pkg"},
+			BuildTags:        "goverter",
+			WorkingDir:       "dir",
+			EnumTransformers: map[string]enum.Transformer{},
+			Global: config.RawLines{
+				Location: "command line (-g, -global)",
+				Lines:    []string{"g1"},
+			},
+		},
+	}
+	require.Equal(t, expected, actual)
+}
+
+func TestList(t *testing.T) {
+	actual, err := cli.Parse([]string{"goverter", "list", "-format", "json", "pkg1", "pkg2"})
+	require.NoError(t, err)
+
+	expected := &cli.List{
+		JSON: true,
+		Config: &goverter.GenerateConfig{
+			PackagePatterns:  []string{"pkg1", "pkg2"},
+			BuildTags:        "goverter",
+			EnumTransformers: map[string]enum.Transformer{},
+			Global: config.RawLines{
+				Location: "command line (-g, -global)",
+			},
+		},
+	}
+	require.Equal(t, expected, actual)
+}
+
+func TestInit(t *testing.T) {
+	actual, err := cli.Parse([]string{"goverter", "init", "-source", "pkg.A", "-target", "pkg.B", "-out", "./convert", "-cwd", "dir"})
+	require.NoError(t, err)
+
+	expected := &cli.Init{
+		Config: &scaffold.Config{
+			Source:     "pkg.A",
+			Target:     "pkg.B",
+			OutputDir:  "./convert",
+			WorkingDir: "dir",
+			BuildTags:  "goverter",
+		},
+	}
+	require.Equal(t, expected, actual)
+}
+
+func TestStrict(t *testing.T) {
+	actual, err := cli.Parse([]string{"goverter", "check", "-strict", "pattern"})
+	require.NoError(t, err)
+	require.True(t, actual.(*cli.Check).Config.Strict)
+
+	actual, err = cli.Parse([]string{"goverter", "gen", "pattern"})
+	require.NoError(t, err)
+	require.False(t, actual.(*cli.Generate).Config.Strict)
+}
+
+func TestClean(t *testing.T) {
+	actual, err := cli.Parse([]string{"goverter", "gen", "-clean", "-dry-run", "pattern"})
+	require.NoError(t, err)
+	require.True(t, actual.(*cli.Generate).Config.Clean)
+	require.True(t, actual.(*cli.Generate).Config.DryRun)
+}
+
+func TestStdout(t *testing.T) {
+	actual, err := cli.Parse([]string{"goverter", "gen", "-stdout", "pattern"})
+	require.NoError(t, err)
+	require.Equal(t, os.Stdout, actual.(*cli.Generate).Config.Output)
+
+	actual, err = cli.Parse([]string{"goverter", "gen", "pattern"})
+	require.NoError(t, err)
+	require.Nil(t, actual.(*cli.Generate).Config.Output)
+}
+
+func TestHeader(t *testing.T) {
+	actual, err := cli.Parse([]string{"goverter", "check", "-header", "@file LICENSE", "pattern"})
+	require.NoError(t, err)
+	require.Equal(t, "@file LICENSE", actual.(*cli.Check).Config.OutputHeader)
+}
+
+func TestFix(t *testing.T) {
+	actual, err := cli.Parse([]string{"goverter", "gen", "-fix", "pattern"})
+	require.NoError(t, err)
+	require.True(t, actual.(*cli.Generate).Config.Fix)
+}
+
 func TestDefault(t *testing.T) {
 	actual, err := cli.Parse([]string{"goverter", "gen", "pattern"})
 	require.NoError(t, err)
@@ -102,6 +235,6 @@ func TestDefault(t *testing.T) {
 			Location: "command line (-g, -global)",
 			Lines:    nil,
 		},
-	}}
+	}, diagnostic.FormatText}
 	require.Equal(t, expected, actual)
 }
@@ -6,7 +6,9 @@ import (
 	"runtime/debug"
 
 	"github.com/jmattheis/goverter"
+	"github.com/jmattheis/goverter/diagnostic"
 	"github.com/jmattheis/goverter/enum"
+	"github.com/jmattheis/goverter/generator"
 )
 
 type RunOpts struct {
@@ -26,16 +28,51 @@ func Run(args []string, opts RunOpts) {
 		_, _ = fmt.Fprintln(os.Stdout, cmd.Usage)
 		os.Exit(0)
 	case *Generate:
-		if opts.EnumTransformers != nil {
-			for key, value := range opts.EnumTransformers {
-				cmd.Config.EnumTransformers[key] = value
+		warnings := prepareConfig(cmd.Config, opts)
+		cmd.Config.Fixed = func(fix *diagnostic.Fix) {
+			_, _ = fmt.Fprintf(os.Stderr, "Fixed %s: added goverter:%s\n", fix.Location, fix.Setting)
+		}
+		cmd.Config.Changed = func(path string, change goverter.FileChange) {
+			_, _ = fmt.Fprintf(os.Stderr, "%s %s\n", change, path)
+		}
+		err = goverter.GenerateConverters(cmd.Config)
+		printDiagnostics(cmd.Format, *warnings, err)
+	case *Check:
+		warnings := prepareConfig(cmd.Config, opts)
+		err = goverter.CheckConverters(cmd.Config)
+		printDiagnostics(cmd.Format, *warnings, err)
+	case *Explain:
+		prepareConfig(cmd.Config, opts)
+		explanation, err := goverter.ExplainMethod(cmd.Config, cmd.Method)
+		if err != nil {
+			_, _ = fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		_, _ = fmt.Fprint(os.Stdout, explanation.Text())
+	case *List:
+		prepareConfig(cmd.Config, opts)
+		infos, err := goverter.ListConverters(cmd.Config)
+		if err != nil {
+			_, _ = fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		if cmd.JSON {
+			out, err := generator.ListJSON(infos)
+			if err != nil {
+				_, _ = fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
 			}
+			_, _ = os.Stdout.Write(out)
+		} else {
+			_, _ = fmt.Fprint(os.Stdout, generator.ListText(infos))
 		}
-
-		if err = goverter.GenerateConverters(cmd.Config); err != nil {
+	case *Init:
+		file, err := goverter.InitConverter(cmd.Config)
+		if err != nil {
 			_, _ = fmt.Fprintln(os.Stderr, err)
 			os.Exit(1)
 		}
+		_, _ = fmt.Fprintf(os.Stdout, "Created %s\n", file)
 	case *Version:
 		b, ok := debug.ReadBuildInfo()
 		if ok {
@@ -45,3 +82,36 @@ func Run(args []string, opts RunOpts) {
 		panic("unknown command")
 	}
 }
+
+func prepareConfig(c *goverter.GenerateConfig, opts RunOpts) *[]*diagnostic.Diagnostic {
+	for key, value := range opts.EnumTransformers {
+		c.EnumTransformers[key] = value
+	}
+
+	warnings := []*diagnostic.Diagnostic{}
+	c.Warn = func(d *diagnostic.Diagnostic) {
+		warnings = append(warnings, d)
+	}
+	return &warnings
+}
+
+func printDiagnostics(format diagnostic.Format, warnings []*diagnostic.Diagnostic, err error) {
+	if format != diagnostic.FormatText {
+		out, renderErr := diagnostic.Render(format, append(warnings, diagnostic.FromError(err)...))
+		if renderErr != nil {
+			_, _ = fmt.Fprintln(os.Stderr, renderErr)
+			os.Exit(1)
+		}
+		_, _ = fmt.Fprintln(os.Stdout, string(out))
+	} else {
+		for _, warning := range warnings {
+			_, _ = fmt.Fprintf(os.Stderr, "Warning: %s\n\n", warning)
+		}
+		if err != nil {
+			_, _ = fmt.Fprintln(os.Stderr, err)
+		}
+	}
+	if err != nil {
+		os.Exit(1)
+	}
+}
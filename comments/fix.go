@@ -0,0 +1,67 @@
+package comments
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jmattheis/goverter/diagnostic"
+)
+
+// InsertFixes adds the settings of the fixes as goverter comments above the
+// method at the fix location. The comment is indented like the method.
+func InsertFixes(fixes []*diagnostic.Fix) error {
+	type insert struct {
+		line    int
+		setting string
+		index   int
+	}
+
+	files := map[string][]insert{}
+	for i, fix := range fixes {
+		idx := strings.LastIndex(fix.Location, ":")
+		if idx == -1 {
+			return fmt.Errorf("invalid fix location %q", fix.Location)
+		}
+		line, err := strconv.Atoi(fix.Location[idx+1:])
+		if err != nil || line < 1 {
+			return fmt.Errorf("invalid fix location %q", fix.Location)
+		}
+		file := fix.Location[:idx]
+		files[file] = append(files[file], insert{line: line, setting: fix.Setting, index: i})
+	}
+
+	for file, inserts := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		lines := strings.SplitAfter(string(content), "\n")
+
+		// insert from bottom to top, so that the line numbers stay valid.
+		sort.SliceStable(inserts, func(i, j int) bool {
+			if inserts[i].line != inserts[j].line {
+				return inserts[i].line > inserts[j].line
+			}
+			return inserts[i].index > inserts[j].index
+		})
+
+		for _, ins := range inserts {
+			if ins.line > len(lines) {
+				return fmt.Errorf("invalid fix location %s:%d, the file has %d lines", file, ins.line, len(lines))
+			}
+			method := lines[ins.line-1]
+			indent := method[:len(method)-len(strings.TrimLeft(method, " \t"))]
+			comment := indent + "// goverter:" + ins.setting + "\n"
+
+			lines = append(lines[:ins.line-1], append([]string{comment}, lines[ins.line-1:]...)...)
+		}
+
+		if err := os.WriteFile(file, []byte(strings.Join(lines, "")), 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
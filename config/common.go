@@ -6,6 +6,7 @@ import (
 
 	"github.com/jmattheis/goverter/config/parse"
 	"github.com/jmattheis/goverter/enum"
+	"github.com/jmattheis/goverter/suggest"
 )
 
 type Common struct {
@@ -22,8 +23,42 @@ type Common struct {
 	UseZeroValueOnPointerInconsistency bool
 	UseUnderlyingTypeMethods           bool
 	DefaultUpdate                      bool
+	DefaultValueOnZero                 bool
 	ArgContextRegex                    *regexp.Regexp
+	RequireNonZero                     *regexp.Regexp
+	IgnorePatterns                     []*FieldPattern
 	Enum                               enum.Config
+	// CtxCheckEvery is the number of list and map iterations after which the
+	// context.Context is checked for cancellation, 0 disables the check.
+	CtxCheckEvery int
+	// ListParallel is the minimum length of source lists that are converted
+	// in parallel, 0 disables the parallel conversion.
+	ListParallel int
+}
+
+// commonSettings contains the keys of the settings parsed by parseCommon.
+var commonSettings = []string{
+	"wrapErrors",
+	"wrapErrorsUsing",
+	"ignoreUnexported",
+	"update:ignoreZeroValueField",
+	"update:ignoreZeroValueField:basic",
+	"update:ignoreZeroValueField:struct",
+	"update:ignoreZeroValueField:nillable",
+	"default:update",
+	"default:value:zero",
+	"ignore:pattern",
+	"matchIgnoreCase",
+	"ignoreMissing",
+	"skipCopySameType",
+	"useZeroValueOnPointerInconsistency",
+	"useUnderlyingTypeMethods",
+	"enum",
+	"arg:context:regex",
+	"requireNonZero",
+	"enum:unknown",
+	"ctx:checkEvery",
+	"list:parallel",
 }
 
 func parseCommon(c *Common, cmd, rest string) (fieldSetting bool, err error) {
@@ -54,6 +89,15 @@ func parseCommon(c *Common, cmd, rest string) (fieldSetting bool, err error) {
 		c.IgnoreNillableZeroValueField, err = parse.Bool(rest)
 	case "default:update":
 		c.DefaultUpdate, err = parse.Bool(rest)
+	case "default:value:zero":
+		fieldSetting = true
+		c.DefaultValueOnZero, err = parse.Bool(rest)
+	case "ignore:pattern":
+		fieldSetting = true
+		var patterns []*FieldPattern
+		patterns, err = parseFieldPatterns(rest)
+		// copy to not modify the slice of the inherited settings
+		c.IgnorePatterns = append(append([]*FieldPattern{}, c.IgnorePatterns...), patterns...)
 	case "matchIgnoreCase":
 		fieldSetting = true
 		c.MatchIgnoreCase, err = parse.Bool(rest)
@@ -70,16 +114,51 @@ func parseCommon(c *Common, cmd, rest string) (fieldSetting bool, err error) {
 		c.Enum.Enabled, err = parse.Bool(rest)
 	case "arg:context:regex":
 		c.ArgContextRegex, err = parse.Regex(rest)
+	case "requireNonZero":
+		fieldSetting = true
+		c.RequireNonZero, err = parse.Regex(rest)
 	case "enum:unknown":
 		c.Enum.Unknown, err = parse.String(rest)
 		if err == nil && IsEnumAction(c.Enum.Unknown) {
 			err = validateEnumAction(c.Enum.Unknown)
 		}
+	case "ctx:checkEvery":
+		c.CtxCheckEvery, err = parse.Int(rest)
+		if err == nil && c.CtxCheckEvery < 0 {
+			err = fmt.Errorf("must not be negative")
+		}
+	case "list:parallel":
+		c.ListParallel, err = parse.Int(rest)
+		if err == nil && c.ListParallel < 0 {
+			err = fmt.Errorf("must not be negative")
+		}
 	case "":
 		err = fmt.Errorf("missing setting key")
 	default:
-		err = fmt.Errorf("unknown setting: %s", cmd)
+		err = &unknownSettingError{setting: cmd, known: commonSettings}
 	}
 
 	return fieldSetting, err
 }
+
+type unknownSettingError struct {
+	setting string
+	known   []string
+}
+
+func (e *unknownSettingError) Error() string {
+	msg := "unknown setting: " + e.setting
+	if hint := suggest.Hint(suggest.Similar(e.setting, e.known)); hint != "" {
+		msg += "\n\n" + hint
+	}
+	return msg
+}
+
+// withKnownSettings adds settings to the suggestions of an unknown setting
+// error.
+func withKnownSettings(err error, settings []string) error {
+	if unknown, ok := err.(*unknownSettingError); ok {
+		unknown.known = append(append([]string{}, unknown.known...), settings...)
+	}
+	return err
+}
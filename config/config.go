@@ -5,6 +5,7 @@ import (
 	"sort"
 
 	"github.com/jmattheis/goverter/config/parse"
+	"github.com/jmattheis/goverter/diagnostic"
 	"github.com/jmattheis/goverter/enum"
 	"github.com/jmattheis/goverter/pkgload"
 )
@@ -30,14 +31,22 @@ type Raw struct {
 	WorkDir              string
 	BuildTags            string
 	OuputBuildConstraint string
+	// OutputHeader is the default output:header value of all converters.
+	OutputHeader string
 
 	EnumTransformers map[string]enum.Transformer
+
+	// KeepGoing collects the errors of all converters and methods instead of
+	// returning the first one.
+	KeepGoing bool
 }
 
 type context struct {
 	Loader           *pkgload.PackageLoader
 	WorkDir          string
 	EnumTransformers map[string]enum.Transformer
+	KeepGoing        bool
+	OutputHeader     string
 }
 
 func Parse(raw *Raw) ([]*Converter, error) {
@@ -46,13 +55,23 @@ func Parse(raw *Raw) ([]*Converter, error) {
 		return nil, err
 	}
 
-	ctx := &context{Loader: loader, EnumTransformers: raw.EnumTransformers, WorkDir: raw.WorkDir}
+	ctx := &context{Loader: loader, EnumTransformers: raw.EnumTransformers, WorkDir: raw.WorkDir, KeepGoing: raw.KeepGoing}
+	if raw.OutputHeader != "" {
+		if ctx.OutputHeader, err = parseHeader(ctx, raw.WorkDir, raw.OutputHeader); err != nil {
+			return nil, fmt.Errorf("error parsing -header: %s", err)
+		}
+	}
 
 	converters := []*Converter{}
+	errs := []error{}
 	for _, rawConverter := range raw.Converters {
 		converter, err := parseConverter(ctx, &rawConverter, raw.Global)
 		if err != nil {
-			return nil, err
+			if !ctx.KeepGoing {
+				return nil, err
+			}
+			errs = append(errs, err)
+			continue
 		}
 		converters = append(converters, converter)
 	}
@@ -61,7 +80,73 @@ func Parse(raw *Raw) ([]*Converter, error) {
 		return converters[i].Name < converters[j].Name
 	})
 
-	return converters, nil
+	converters, checkErrs := checkNameCollisions(converters)
+	if len(checkErrs) > 0 && !ctx.KeepGoing {
+		return nil, checkErrs[0]
+	}
+	errs = append(errs, checkErrs...)
+
+	return converters, diagnostic.Join(errs...)
+}
+
+// checkNameCollisions ensures that the singleton variables and constructors
+// of the converters in the same output package don't collide with each other
+// or with the converter structs. Converters with errors are removed.
+func checkNameCollisions(converters []*Converter) ([]*Converter, []error) {
+	type declaration struct {
+		kind      string
+		converter *Converter
+	}
+	declared := map[string]map[string]declaration{}
+	declare := func(c *Converter, name, kind string) {
+		if declared[c.PackageID()] == nil {
+			declared[c.PackageID()] = map[string]declaration{}
+		}
+		declared[c.PackageID()][name] = declaration{kind: kind, converter: c}
+	}
+	collision := func(c *Converter, name, kind string) string {
+		other, ok := declared[c.PackageID()][name]
+		if !ok || other.converter == c {
+			return ""
+		}
+		return fmt.Sprintf("The %s %s has the same name as the %s of\n    %s\n    %s", kind, name, other.kind, other.converter.Location, other.converter.IDString())
+	}
+
+	for _, c := range converters {
+		if c.OutputFormat == FormatStruct {
+			declare(c, c.Name, "converter struct")
+		}
+	}
+
+	valid := []*Converter{}
+	errs := []error{}
+	for _, c := range converters {
+		if c.OutputFormat != FormatStruct {
+			valid = append(valid, c)
+			continue
+		}
+
+		if c.Singleton {
+			name := c.SingletonID()
+			if err := singletonError(c, collision(c, name, "singleton variable")); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			if c.Singleton {
+				declare(c, name, "singleton variable")
+			}
+		}
+
+		if name := c.ConstructorID(); name != "" {
+			if err := constructorError(c, collision(c, name, "constructor")); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			declare(c, name, "constructor")
+		}
+		valid = append(valid, c)
+	}
+	return valid, errs
 }
 
 func formatLineError(lines RawLines, t, value string, err error) error {
@@ -71,5 +156,11 @@ func formatLineError(lines RawLines, t, value string, err error) error {
     %s
 
 %s`
-	return fmt.Errorf(msg, cmd, lines.Location, t, err)
+	return &diagnostic.Diagnostic{
+		Severity: diagnostic.SeverityError,
+		Message:  fmt.Sprintf(msg, cmd, lines.Location, t, err),
+		Location: lines.Location,
+		Setting:  "goverter:" + cmd,
+		Cause:    err.Error(),
+	}
 }
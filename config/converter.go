@@ -2,11 +2,13 @@ package config
 
 import (
 	"fmt"
+	"go/token"
 	"go/types"
 	"path/filepath"
 	"strings"
 
 	"github.com/jmattheis/goverter/config/parse"
+	"github.com/jmattheis/goverter/diagnostic"
 	"github.com/jmattheis/goverter/enum"
 	"github.com/jmattheis/goverter/method"
 	"github.com/jmattheis/goverter/pkgload"
@@ -25,6 +27,15 @@ const (
 	FormatFunction Format = "function"
 )
 
+// Split defines how the generated methods are distributed to files.
+type Split string
+
+const (
+	SplitNone   Split = "none"
+	SplitMethod Split = "method"
+	SplitType   Split = "type"
+)
+
 var DefaultCommon = Common{
 	Enum: enum.Config{Enabled: true},
 }
@@ -33,10 +44,13 @@ var DefaultConfigInterface = ConverterConfig{
 	OutputFile:   "./generated/generated.go",
 	Common:       DefaultCommon,
 	OutputFormat: FormatStruct,
+	OutputSplit:  SplitNone,
+	Singleton:    true,
 }
 
 var DefaultConfigVariables = ConverterConfig{
 	OutputFormat: FormatVariable,
+	OutputSplit:  SplitNone,
 	Common:       DefaultCommon,
 }
 
@@ -46,6 +60,10 @@ type Converter struct {
 	FileName string
 	typ      types.Type
 	Methods  []*Method
+	fileData *FileData
+
+	// Warnings found while parsing the converter settings.
+	Warnings []*diagnostic.Diagnostic
 
 	Location string
 }
@@ -64,6 +82,33 @@ func (c *Converter) requireStruct() error {
 	return fmt.Errorf("not allowed when using goverter:variables")
 }
 
+// Interface returns the converter interface, it's nil for goverter:variables.
+func (c *Converter) Interface() *types.Named {
+	named, _ := c.typ.(*types.Named)
+	return named
+}
+
+// TypeParams returns the type parameters of a generic converter interface,
+// it's nil if the converter isn't generic.
+func (c *Converter) TypeParams() *types.TypeParamList {
+	if named := c.Interface(); named != nil && named.TypeParams().Len() > 0 {
+		return named.TypeParams()
+	}
+	return nil
+}
+
+// ConstructorID returns the name of the generated constructor, it's empty if
+// no constructor is generated.
+func (c *Converter) ConstructorID() string {
+	if !c.OutputConstructor || c.OutputFormat != FormatStruct || c.Interface() == nil {
+		return ""
+	}
+	if c.OutputConstructorName != "" {
+		return c.OutputConstructorName
+	}
+	return "New" + c.Interface().Obj().Name()
+}
+
 func (c *Converter) IDString() string {
 	if c.typ == nil {
 		return "var definition"
@@ -76,11 +121,44 @@ type ConverterConfig struct {
 	Name              string
 	OutputRaw         []string
 	OutputFile        string
+	OutputDocs        string
 	OutputPackagePath string
 	OutputPackageName string
 	OutputFormat      Format
+	OutputSplit       Split
 	Extend            []*method.Definition
+	ExtendSettings    []*ExtendSetting
 	Comments          []string
+	// Singleton generates a variable containing an instance of the converter
+	// struct.
+	Singleton        bool
+	SingletonName    string
+	SingletonPointer bool
+
+	singletonExplicit bool
+
+	// OutputAssert generates a compile-time assertion that the converter
+	// struct implements the interface.
+	OutputAssert bool
+	// OutputConstructor generates a function returning a new converter.
+	OutputConstructor     bool
+	OutputConstructorName string
+	// OutputShareHelpers generates helper methods that don't use the
+	// converter as functions, which are shared between the converters of the
+	// output file.
+	OutputShareHelpers bool
+	// OutputHeader is the template of the comment written above the
+	// generated code marker.
+	OutputHeader string
+}
+
+// ExtendSetting is a single goverter:extend setting with the functions it
+// added.
+type ExtendSetting struct {
+	Raw  string
+	Defs []*method.Definition
+	// Global is set when the setting was defined via the CLI.
+	Global bool
 }
 
 func (conf *ConverterConfig) PackageID() string {
@@ -90,6 +168,19 @@ func (conf *ConverterConfig) PackageID() string {
 	return conf.OutputPackagePath + ":" + conf.OutputPackageName
 }
 
+// SingletonID returns the name of the singleton variable. It defaults to the
+// struct name without the Impl suffix and with a Convert suffix.
+func (conf *ConverterConfig) SingletonID() string {
+	if conf.SingletonName != "" {
+		return conf.SingletonName
+	}
+	name := strings.TrimSuffix(conf.Name, "Impl")
+	if name == "" {
+		name = conf.Name
+	}
+	return strings.ToUpper(name[:1]) + name[1:] + "Convert"
+}
+
 func defaultOutputFile(name string) string {
 	f := filepath.Base(name)
 	ext := filepath.Ext(f)
@@ -102,19 +193,179 @@ func parseConverter(ctx *context, rawConverter *RawConverter, global RawLines) (
 		return nil, err
 	}
 
+	if ctx.OutputHeader != "" {
+		c.OutputHeader = ctx.OutputHeader
+	}
 	if err := parseConverterLines(ctx, c, "global", global); err != nil {
 		return nil, err
 	}
+	for _, pattern := range c.IgnorePatterns {
+		pattern.Global = true
+	}
+	for _, extend := range c.ExtendSettings {
+		extend.Global = true
+	}
 	if err := parseConverterLines(ctx, c, c.IDString(), rawConverter.Converter); err != nil {
 		return nil, err
 	}
 
 	resolveOutputPackage(ctx, c)
+	if err := checkSingleton(ctx, c); err != nil {
+		return nil, err
+	}
+	if err := checkConstructor(ctx, c); err != nil {
+		return nil, err
+	}
+	checkShareHelpers(c, rawConverter.Converter)
 
 	err = parseMethods(ctx, rawConverter, c)
 	return c, err
 }
 
+// checkSingleton ensures that the singleton variable doesn't collide with the
+// converter struct or an existing declaration of the output package. The
+// variable is omitted with a warning on collisions, if it wasn't explicitly
+// configured.
+func checkSingleton(ctx *context, c *Converter) error {
+	if c.OutputFormat != FormatStruct || !c.Singleton {
+		return nil
+	}
+
+	name := c.SingletonID()
+	cause := ""
+	if c.TypeParams() != nil {
+		if !c.singletonExplicit {
+			// generic converter structs cannot be instantiated without type
+			// arguments, so there is no default variable to omit.
+			c.Singleton = false
+			return nil
+		}
+		cause = fmt.Sprintf("The singleton variable %s cannot be generated for the generic converter struct.", name)
+	} else if name == c.Name {
+		cause = fmt.Sprintf("The singleton variable %s has the same name as the converter struct.", name)
+	} else if position := declaredInOutputPackage(ctx, c, name); position != "" {
+		cause = fmt.Sprintf("The singleton variable %s is already declared in the output package at\n    %s", name, position)
+	}
+	return singletonError(c, cause)
+}
+
+// checkShareHelpers disables output:shareHelpers for generic converters,
+// because their helpers may use the type parameters. A warning is added if
+// the setting was defined on the converter.
+func checkShareHelpers(c *Converter, lines RawLines) {
+	if !c.OutputShareHelpers || c.TypeParams() == nil {
+		return
+	}
+	c.OutputShareHelpers = false
+
+	for _, line := range lines.Lines {
+		if cmd, _ := parse.Command(line); cmd == "output:shareHelpers" {
+			cause := "The helper methods of generic converters cannot be shared, because they may use the type parameters.\n\nRemove goverter:output:shareHelpers from the converter."
+			c.Warnings = append(c.Warnings, &diagnostic.Diagnostic{
+				Severity: diagnostic.SeverityWarning,
+				Message:  fmt.Sprintf("Disabled output:shareHelpers of converter:\n    %s\n    %s\n\n%s", c.Location, c.IDString(), cause),
+				Location: c.Location,
+				Setting:  "goverter:output:shareHelpers",
+				Cause:    cause,
+			})
+			return
+		}
+	}
+}
+
+// checkConstructor ensures that the constructor doesn't collide with the
+// converter struct, the singleton variable or an existing declaration of the
+// output package.
+func checkConstructor(ctx *context, c *Converter) error {
+	name := c.ConstructorID()
+	if name == "" {
+		return nil
+	}
+
+	cause := ""
+	if name == c.Name {
+		cause = fmt.Sprintf("The constructor %s has the same name as the converter struct.", name)
+	} else if c.Singleton && name == c.SingletonID() {
+		cause = fmt.Sprintf("The constructor %s has the same name as the singleton variable.", name)
+	} else if position := declaredInOutputPackage(ctx, c, name); position != "" {
+		cause = fmt.Sprintf("The constructor %s is already declared in the output package at\n    %s", name, position)
+	}
+	return constructorError(c, cause)
+}
+
+func constructorError(c *Converter, cause string) error {
+	if cause == "" {
+		return nil
+	}
+	cause += "\n\nChange the name with goverter:output:constructor:name or disable the constructor with goverter:output:constructor no."
+	return &diagnostic.Diagnostic{
+		Severity: diagnostic.SeverityError,
+		Message:  fmt.Sprintf("Invalid constructor of converter:\n    %s\n    %s\n\n%s", c.Location, c.IDString(), cause),
+		Location: c.Location,
+		Setting:  "goverter:output:constructor",
+		Cause:    cause,
+	}
+}
+
+// declaredInOutputPackage returns the file:line of the declaration of name
+// in the output package, if it's declared outside of the generated file.
+func declaredInOutputPackage(ctx *context, c *Converter, name string) string {
+	pkg := ctx.Loader.GetUncheckedPkg(c.OutputPackagePath)
+	if pkg == nil || pkg.Types == nil {
+		return ""
+	}
+	obj := pkg.Types.Scope().Lookup(name)
+	if obj == nil {
+		return ""
+	}
+	position := pkg.Fset.Position(obj.Pos())
+	if position.Filename == outputFile(c) {
+		return ""
+	}
+	return fmt.Sprintf("%s:%d", position.Filename, position.Line)
+}
+
+// singletonError omits the singleton variable and adds a warning, if the
+// variable wasn't explicitly configured, otherwise an error is returned.
+func singletonError(c *Converter, cause string) error {
+	if cause == "" {
+		return nil
+	}
+	if !c.singletonExplicit {
+		c.Singleton = false
+		cause += "\n\nThe variable is omitted. Change the name with goverter:output:singleton:name or disable the variable with goverter:output:singleton no."
+		c.Warnings = append(c.Warnings, &diagnostic.Diagnostic{
+			Severity: diagnostic.SeverityWarning,
+			Message:  fmt.Sprintf("Omitted singleton variable of converter:\n    %s\n    %s\n\n%s", c.Location, c.IDString(), cause),
+			Location: c.Location,
+			Setting:  "goverter:output:singleton",
+			Cause:    cause,
+		})
+		return nil
+	}
+
+	if c.TypeParams() != nil {
+		cause += "\n\nDisable the variable with goverter:output:singleton no."
+	} else {
+		cause += "\n\nChange the name with goverter:output:singleton:name or disable the variable with goverter:output:singleton no."
+	}
+	return &diagnostic.Diagnostic{
+		Severity: diagnostic.SeverityError,
+		Message:  fmt.Sprintf("Invalid singleton variable of converter:\n    %s\n    %s\n\n%s", c.Location, c.IDString(), cause),
+		Location: c.Location,
+		Setting:  "goverter:output:singleton",
+		Cause:    cause,
+	}
+}
+
+// outputFile returns the absolute path of the generated file.
+func outputFile(c *Converter) string {
+	if filepath.IsAbs(c.OutputFile) {
+		return c.OutputFile
+	}
+	return filepath.Join(filepath.Dir(c.FileName), c.OutputFile)
+}
+
 func resolveOutputPackage(ctx *context, c *Converter) {
 	targetPackage, err := resolvePackage(c.FileName, c.Package, c.OutputFile)
 	if err != nil {
@@ -141,6 +392,7 @@ func initConverter(loader *pkgload.PackageLoader, rawConverter *RawConverter) (*
 		FileName: rawConverter.FileName,
 		Package:  rawConverter.PackagePath,
 		Location: rawConverter.Converter.Location,
+		fileData: newFileData(rawConverter),
 	}
 
 	if rawConverter.InterfaceName != "" {
@@ -172,6 +424,29 @@ func parseConverterLines(ctx *context, c *Converter, source string, raw RawLines
 	return nil
 }
 
+// converterSettings contains the keys of the converter settings parsed by
+// parseConverterLine.
+var converterSettings = []string{
+	"name",
+	"output:raw",
+	"output:assert",
+	"output:constructor",
+	"output:constructor:name",
+	configOutputFile,
+	"output:docs",
+	"output:format",
+	"output:header",
+	"output:package",
+	"output:split",
+	"output:shareHelpers",
+	"output:singleton",
+	"output:singleton:name",
+	"output:singleton:pointer",
+	"struct:comment",
+	"enum:exclude",
+	configExtend,
+}
+
 func parseConverterLine(ctx *context, c *Converter, value string) (err error) {
 	cmd, rest := parse.Command(value)
 	switch cmd {
@@ -185,7 +460,16 @@ func parseConverterLine(ctx *context, c *Converter, value string) (err error) {
 	case "output:raw":
 		c.OutputRaw = append(c.OutputRaw, rest)
 	case configOutputFile:
-		c.OutputFile, err = parse.File(ctx.WorkDir, rest)
+		c.OutputFile, err = parse.File(ctx.WorkDir, rest, c.fileData.values())
+	case "output:docs":
+		c.OutputDocs, err = parse.File(ctx.WorkDir, rest, c.fileData.values())
+		if err == nil {
+			switch filepath.Ext(c.OutputDocs) {
+			case ".md", ".html":
+			default:
+				err = fmt.Errorf("unsupported docs format %q, expected a .md or .html file", c.OutputDocs)
+			}
+		}
 	case "output:format":
 		if len(c.Extend) != 0 {
 			return fmt.Errorf("Cannot change output:format after extend functions have been added.\nMove the extend below the output:format setting.")
@@ -215,6 +499,52 @@ func parseConverterLine(ctx *context, c *Converter, value string) (err error) {
 		case 1:
 			c.OutputPackagePath = parts[0]
 		}
+	case "output:header":
+		c.OutputHeader, err = parseHeader(ctx, filepath.Dir(c.FileName), rest)
+	case "output:split":
+		c.OutputSplit, err = parse.Enum(false, rest, SplitNone, SplitMethod, SplitType)
+	case "output:shareHelpers":
+		c.OutputShareHelpers, err = parse.Bool(rest)
+	case "output:assert":
+		if err = c.requireStruct(); err != nil {
+			return err
+		}
+		c.OutputAssert, err = parse.Bool(rest)
+	case "output:constructor":
+		if err = c.requireStruct(); err != nil {
+			return err
+		}
+		c.OutputConstructor, err = parse.Bool(rest)
+	case "output:constructor:name":
+		if err = c.requireStruct(); err != nil {
+			return err
+		}
+		c.OutputConstructorName, err = parse.String(rest)
+		if err == nil && !token.IsIdentifier(c.OutputConstructorName) {
+			err = fmt.Errorf("%q is not a valid identifier", c.OutputConstructorName)
+		}
+		c.OutputConstructor = true
+	case "output:singleton":
+		if err = c.requireStruct(); err != nil {
+			return err
+		}
+		c.Singleton, err = parse.Bool(rest)
+		c.singletonExplicit = true
+	case "output:singleton:name":
+		if err = c.requireStruct(); err != nil {
+			return err
+		}
+		c.SingletonName, err = parse.String(rest)
+		if err == nil && !token.IsIdentifier(c.SingletonName) {
+			err = fmt.Errorf("%q is not a valid identifier", c.SingletonName)
+		}
+		c.Singleton = true
+		c.singletonExplicit = true
+	case "output:singleton:pointer":
+		if err = c.requireStruct(); err != nil {
+			return err
+		}
+		c.SingletonPointer, err = parse.Bool(rest)
 	case "struct:comment":
 		if err = c.requireStruct(); err != nil {
 			return err
@@ -232,7 +562,9 @@ func parseConverterLine(ctx *context, c *Converter, value string) (err error) {
 				c.OutputPackagePath = targetPackage
 			}
 		}
-		
+
+		setting := &ExtendSetting{Raw: value}
+		c.ExtendSettings = append(c.ExtendSettings, setting)
 		for _, name := range strings.Fields(rest) {
 			opts := &method.ParseOpts{
 				ErrorPrefix:       "error parsing type",
@@ -247,9 +579,11 @@ func parseConverterLine(ctx *context, c *Converter, value string) (err error) {
 				break
 			}
 			c.Extend = append(c.Extend, defs...)
+			setting.Defs = append(setting.Defs, defs...)
 		}
 	default:
 		_, err = parseCommon(&c.Common, cmd, rest)
+		err = withKnownSettings(err, converterSettings)
 	}
 	return err
 }
@@ -0,0 +1,125 @@
+package config
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"github.com/jmattheis/goverter/method"
+	"github.com/jmattheis/goverter/pkgload"
+	"github.com/jmattheis/goverter/xtype"
+)
+
+const configDefaultValue = "default:value"
+
+// DefaultValue is the fallback value of a target field. Exactly one of
+// Literal, Const or Function is set.
+type DefaultValue struct {
+	Raw string
+
+	// Literal is a go literal like "EUR", 100 or true.
+	Literal string
+	// Const is a package level constant or variable.
+	Const types.Object
+	// Function is called without a source to create the value.
+	Function *method.Definition
+
+	// Type is the type of Literal or Const.
+	Type types.Type
+	// Value is the constant value of Literal.
+	Value constant.Value
+}
+
+func parseMethodDefaultValue(remaining string) (target, value string, err error) {
+	parts := strings.SplitN(strings.TrimSpace(remaining), " ", 2)
+	if len(parts) != 2 || strings.TrimSpace(parts[1]) == "" {
+		return "", "", fmt.Errorf("expected 2 values: TARGET VALUE, got: %q", remaining)
+	}
+	target = parts[0]
+	value = strings.TrimSpace(parts[1])
+	if strings.ContainsRune(target, '.') {
+		return "", "", fmt.Errorf("the default value target %q must be a field name but was a path.\nDots \".\" are not allowed.", target)
+	}
+	return target, value, nil
+}
+
+func parseDefaultValue(ctx *context, c *Converter, m *Method, value string) (*DefaultValue, error) {
+	if typ, ok := literalType(value); ok {
+		tv, err := types.Eval(token.NewFileSet(), nil, token.NoPos, value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid literal %s: %s", value, err)
+		}
+		return &DefaultValue{Raw: value, Literal: value, Type: typ, Value: tv.Value}, nil
+	}
+
+	pkgName, name, err := pkgload.ParseMethodString(c.Package, value)
+	if err != nil {
+		return nil, err
+	}
+	_, obj, err := ctx.Loader.GetOneRaw(pkgName, name)
+	if err != nil {
+		return nil, err
+	}
+
+	switch obj.(type) {
+	case *types.Const, *types.Var:
+		if !xtype.Accessible(obj, c.OutputPackagePath) {
+			return nil, fmt.Errorf("%s must be exported or in the same package as the converter", obj.String())
+		}
+		return &DefaultValue{Raw: value, Const: obj, Type: obj.Type()}, nil
+	case *types.Func:
+		opts := &method.ParseOpts{
+			ErrorPrefix:       "error parsing type",
+			OutputPackagePath: c.OutputPackagePath,
+			Converter:         c.typeForMethod(),
+			Params:            method.ParamsNone,
+			ContextMatch:      m.ArgContextRegex,
+		}
+		def, err := ctx.Loader.GetOne(c.Package, value, opts)
+		if err != nil {
+			return nil, err
+		}
+		return &DefaultValue{Raw: value, Function: def}, nil
+	default:
+		return nil, fmt.Errorf("%s must be a literal, constant, variable or function", obj.String())
+	}
+}
+
+// literalType returns the untyped type of a go literal.
+func literalType(value string) (types.Type, bool) {
+	expr, err := parser.ParseExpr(value)
+	if err != nil {
+		return nil, false
+	}
+	if unary, ok := expr.(*ast.UnaryExpr); ok && (unary.Op == token.SUB || unary.Op == token.ADD) {
+		expr = unary.X
+		if lit, ok := expr.(*ast.BasicLit); !ok || (lit.Kind != token.INT && lit.Kind != token.FLOAT) {
+			return nil, false
+		}
+	}
+
+	switch expr := expr.(type) {
+	case *ast.BasicLit:
+		switch expr.Kind {
+		case token.INT:
+			return types.Typ[types.UntypedInt], true
+		case token.FLOAT:
+			return types.Typ[types.UntypedFloat], true
+		case token.IMAG:
+			return types.Typ[types.UntypedComplex], true
+		case token.CHAR:
+			return types.Typ[types.UntypedRune], true
+		case token.STRING:
+			return types.Typ[types.UntypedString], true
+		}
+	case *ast.Ident:
+		if expr.Name == "true" || expr.Name == "false" {
+			return types.Typ[types.UntypedBool], true
+		}
+	}
+	return nil, false
+}
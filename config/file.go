@@ -0,0 +1,65 @@
+package config
+
+import (
+	"path/filepath"
+	"strings"
+	"unicode"
+)
+
+// FileData is passed to the templates of output:file and output:docs.
+type FileData struct {
+	// FileBase is the name of the file with the converter without extension.
+	FileBase string
+	// Package is the name of the package with the converter.
+	Package string
+	// Interface is the name of the converter interface, it's empty for
+	// goverter:variables and cannot be used in the templates.
+	Interface string
+	// InterfaceSnake is Interface in snake_case.
+	InterfaceSnake string
+}
+
+func newFileData(raw *RawConverter) *FileData {
+	base := filepath.Base(raw.FileName)
+	return &FileData{
+		FileBase:       strings.TrimSuffix(base, filepath.Ext(base)),
+		Package:        raw.PackageName,
+		Interface:      raw.InterfaceName,
+		InterfaceSnake: snakeCase(raw.InterfaceName),
+	}
+}
+
+// values returns the variables for the templates. Empty variables are omitted,
+// so that templates using e.g. {{.Interface}} for goverter:variables fail
+// instead of resolving to a file like ./generated/.go.
+func (d *FileData) values() map[string]string {
+	values := map[string]string{}
+	for key, value := range map[string]string{
+		"FileBase":       d.FileBase,
+		"Package":        d.Package,
+		"Interface":      d.Interface,
+		"InterfaceSnake": d.InterfaceSnake,
+	} {
+		if value != "" {
+			values[key] = value
+		}
+	}
+	return values
+}
+
+// snakeCase converts e.g. HTTPUserConverter to http_user_converter.
+func snakeCase(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				b.WriteRune('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
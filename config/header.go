@@ -0,0 +1,94 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/jmattheis/goverter/config/parse"
+)
+
+// HeaderData is passed to the output:header template.
+type HeaderData struct {
+	// Package is the name of the output package.
+	Package string
+	// Converter is the name of the generated converter.
+	Converter string
+}
+
+// Header renders the output:header template of the converter as comment,
+// it's empty if no header is configured. Lines not starting with // are
+// prefixed with //, unless the header is a /* */ comment.
+func (conf *ConverterConfig) Header() (string, error) {
+	if conf.OutputHeader == "" {
+		return "", nil
+	}
+	pkgName := conf.OutputPackageName
+	if pkgName == "" {
+		pkgName = path.Base(conf.OutputPackagePath)
+	}
+	header, err := renderHeader(conf.OutputHeader, HeaderData{
+		Package:   pkgName,
+		Converter: conf.Name,
+	})
+	if err != nil || strings.HasPrefix(header, "/*") {
+		return header, err
+	}
+
+	lines := strings.Split(header, "\n")
+	for i, line := range lines {
+		switch {
+		case strings.HasPrefix(line, "//"):
+		case line == "":
+			lines[i] = "//"
+		default:
+			lines[i] = "// " + line
+		}
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+func renderHeader(text string, data HeaderData) (string, error) {
+	tmpl, err := template.New("header").Parse(text)
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	if err := tmpl.Execute(&b, data); err != nil {
+		return "", err
+	}
+	return strings.TrimRight(b.String(), "\r\n"), nil
+}
+
+// parseHeader parses the value of output:header. It's either the header
+// literal or @file PATH, relative paths are resolved against dir.
+func parseHeader(ctx *context, dir, rest string) (string, error) {
+	rest = strings.TrimSpace(rest)
+	if rest == "" {
+		return "", fmt.Errorf("must have a value")
+	}
+
+	header := rest
+	if cmd, file := parse.Command(rest); cmd == "@file" {
+		name, err := parse.File(ctx.WorkDir, file, nil)
+		if err != nil {
+			return "", err
+		}
+		if !filepath.IsAbs(name) {
+			name = filepath.Join(dir, name)
+		}
+		content, err := os.ReadFile(name)
+		if err != nil {
+			return "", err
+		}
+		header = string(content)
+	}
+
+	if _, err := renderHeader(header, HeaderData{}); err != nil {
+		return "", fmt.Errorf("invalid header template: %s", err)
+	}
+	return header, nil
+}
@@ -0,0 +1,69 @@
+package config
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// FieldPattern matches field names either by a golang regex (/REGEX/) or by a
+// glob pattern (Deprecated*).
+type FieldPattern struct {
+	Raw   string
+	Regex *regexp.Regexp
+	Glob  string
+
+	// Used is set once the pattern matched a field.
+	Used bool
+	// Global is set when the pattern was defined via the CLI.
+	Global bool
+}
+
+func (p *FieldPattern) Matches(name string) bool {
+	if p.Regex != nil {
+		return p.Regex.MatchString(name)
+	}
+	ok, _ := path.Match(p.Glob, name)
+	return ok
+}
+
+func isFieldPattern(value string) bool {
+	return isRegexFieldPattern(value) || strings.ContainsAny(value, "*?[")
+}
+
+func isRegexFieldPattern(value string) bool {
+	return len(value) > 2 && strings.HasPrefix(value, "/") && strings.HasSuffix(value, "/")
+}
+
+func parseFieldPattern(value string) (*FieldPattern, error) {
+	if isRegexFieldPattern(value) {
+		regex, err := regexp.Compile(value[1 : len(value)-1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid regex %s: %s", value, err)
+		}
+		return &FieldPattern{Raw: value, Regex: regex}, nil
+	}
+
+	if _, err := path.Match(value, ""); err != nil {
+		return nil, fmt.Errorf("invalid glob pattern %s: %s", value, err)
+	}
+	return &FieldPattern{Raw: value, Glob: value}, nil
+}
+
+func parseFieldPatterns(rest string) ([]*FieldPattern, error) {
+	fields := strings.Fields(rest)
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("missing pattern")
+	}
+
+	patterns := make([]*FieldPattern, 0, len(fields))
+	for _, field := range fields {
+		pattern, err := parseFieldPattern(field)
+		if err != nil {
+			return nil, err
+		}
+		patterns = append(patterns, pattern)
+	}
+	return patterns, nil
+}
@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"go/types"
 	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/jmattheis/goverter/config/parse"
+	"github.com/jmattheis/goverter/diagnostic"
 	"github.com/jmattheis/goverter/method"
 )
 
@@ -21,12 +23,15 @@ type Method struct {
 	*method.Definition
 	Common
 
-	Constructor *method.Definition
-	AutoMap     []string
-	Fields      map[string]*FieldMapping
-	EnumMapping *EnumMapping
+	Constructor    *method.Definition
+	AutoMap        []string
+	Fields         map[string]*FieldMapping
+	IgnoreMatching []*FieldPattern
+	EnumMapping    *EnumMapping
 
+	RawSettings      []string
 	RawFieldSettings []string
+	RawEnumSettings  []string
 
 	Location    string
 	updateParam string
@@ -38,6 +43,13 @@ type FieldMapping struct {
 	Function *method.Definition
 	Ignore   bool
 	ArgIndex int // 用于argmap，表示从第几个参数获取值，0表示不使用argmap
+	Default  *DefaultValue
+	Required bool
+}
+
+// Mapped returns true, if the source of the field was explicitly defined.
+func (f *FieldMapping) Mapped() bool {
+	return f.Source != "" || f.Function != nil || f.ArgIndex > 0
 }
 
 func (m *Method) Field(targetName string) *FieldMapping {
@@ -50,30 +62,48 @@ func (m *Method) Field(targetName string) *FieldMapping {
 }
 
 func parseMethods(ctx *context, rawConverter *RawConverter, c *Converter) error {
+	errs := []error{}
 	if c.typ != nil {
 		interf := c.typ.Underlying().(*types.Interface)
 		for i := 0; i < interf.NumMethods(); i++ {
 			fun := interf.Method(i)
 			def, err := parseMethod(ctx, c, fun, rawConverter.Methods[fun.Name()])
 			if err != nil {
-				return err
+				if !ctx.KeepGoing {
+					return err
+				}
+				errs = append(errs, err)
+				continue
 			}
 			c.Methods = append(c.Methods, def)
 		}
-		return nil
+		return diagnostic.Join(errs...)
 	}
-	for name, lines := range rawConverter.Methods {
+	for _, name := range sortedKeys(rawConverter.Methods) {
 		_, fn, err := ctx.Loader.GetOneRaw(c.Package, name)
-		if err != nil {
-			return err
+		if err == nil {
+			var def *Method
+			def, err = parseMethod(ctx, c, fn, rawConverter.Methods[name])
+			if err == nil {
+				c.Methods = append(c.Methods, def)
+				continue
+			}
 		}
-		def, err := parseMethod(ctx, c, fn, lines)
-		if err != nil {
+		if !ctx.KeepGoing {
 			return err
 		}
-		c.Methods = append(c.Methods, def)
+		errs = append(errs, err)
 	}
-	return nil
+	return diagnostic.Join(errs...)
+}
+
+func sortedKeys(m map[string]RawLines) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
 }
 
 func parseMethod(ctx *context, c *Converter, obj types.Object, rawMethod RawLines) (*Method, error) {
@@ -81,6 +111,7 @@ func parseMethod(ctx *context, c *Converter, obj types.Object, rawMethod RawLine
 		Common:      c.Common,
 		Fields:      map[string]*FieldMapping{},
 		Location:    rawMethod.Location,
+		RawSettings: rawMethod.Lines,
 		EnumMapping: &EnumMapping{Map: map[string]string{}},
 		localOpts:   method.LocalOpts{Context: map[string]bool{}},
 	}
@@ -117,6 +148,22 @@ func parseMethod(ctx *context, c *Converter, obj types.Object, rawMethod RawLine
 	return m, err
 }
 
+// methodSettings contains the keys of the method settings parsed by
+// parseMethodLine.
+var methodSettings = []string{
+	configMap,
+	"ignore",
+	configDefaultValue,
+	"required",
+	"update",
+	"context",
+	"enum:map",
+	"enum:transform",
+	"autoMap",
+	"argmap",
+	configDefault,
+}
+
 func parseMethodLine(ctx *context, c *Converter, m *Method, value string) (err error) {
 	cmd, rest := parse.Command(value)
 	fieldSetting := false
@@ -146,7 +193,33 @@ func parseMethodLine(ctx *context, c *Converter, m *Method, value string) (err e
 		fieldSetting = true
 		fields := strings.Fields(rest)
 		for _, f := range fields {
-			m.Field(f).Ignore = true
+			if !isFieldPattern(f) {
+				m.Field(f).Ignore = true
+				continue
+			}
+			var pattern *FieldPattern
+			pattern, err = parseFieldPattern(f)
+			if err != nil {
+				return err
+			}
+			m.IgnoreMatching = append(m.IgnoreMatching, pattern)
+		}
+	case configDefaultValue:
+		fieldSetting = true
+		var target, value string
+		target, value, err = parseMethodDefaultValue(rest)
+		if err != nil {
+			return err
+		}
+		m.Field(target).Default, err = parseDefaultValue(ctx, c, m, value)
+	case "required":
+		fieldSetting = true
+		fields := strings.Fields(rest)
+		if len(fields) == 0 {
+			return fmt.Errorf("missing field")
+		}
+		for _, f := range fields {
+			m.Field(f).Required = true
 		}
 	case "update":
 		m.updateParam, err = parse.String(rest)
@@ -203,10 +276,14 @@ func parseMethodLine(ctx *context, c *Converter, m *Method, value string) (err e
 		m.Constructor, err = ctx.Loader.GetOne(c.Package, rest, opts)
 	default:
 		fieldSetting, err = parseCommon(&m.Common, cmd, rest)
+		err = withKnownSettings(err, methodSettings)
 	}
 	if fieldSetting {
 		m.RawFieldSettings = append(m.RawFieldSettings, value)
 	}
+	if cmd == "enum:map" || cmd == "enum:transform" {
+		m.RawEnumSettings = append(m.RawEnumSettings, value)
+	}
 	return err
 }
 
@@ -30,8 +30,9 @@ func getPackages(raw *Raw) []string {
 		// This preemptively loads this package, in case it already exists.
 		lookup[filepath.Join(c.PackagePath, "generated")] = struct{}{}
 
-		registerConverterLines(lookup, raw.WorkDir, c.FileName, c.PackagePath, c.Converter)
-		registerConverterLines(lookup, raw.WorkDir, c.FileName, c.PackagePath, raw.Global)
+		data := newFileData(&c)
+		registerConverterLines(lookup, raw.WorkDir, data, c.FileName, c.PackagePath, c.Converter)
+		registerConverterLines(lookup, raw.WorkDir, data, c.FileName, c.PackagePath, raw.Global)
 		for _, m := range c.Methods {
 			registerMethodLines(lookup, c.PackagePath, m)
 		}
@@ -45,7 +46,7 @@ func getPackages(raw *Raw) []string {
 	return pkgs
 }
 
-func registerConverterLines(lookup map[string]struct{}, cwd, filename, sourcePackage string, lines RawLines) {
+func registerConverterLines(lookup map[string]struct{}, cwd string, data *FileData, filename, sourcePackage string, lines RawLines) {
 	for _, line := range lines.Lines {
 		cmd, rest := parse.Command(line)
 		switch cmd {
@@ -54,7 +55,7 @@ func registerConverterLines(lookup map[string]struct{}, cwd, filename, sourcePac
 				registerFullMethod(lookup, sourcePackage, fullMethod)
 			}
 		case configOutputFile:
-			file, err := parse.File(cwd, rest)
+			file, err := parse.File(cwd, rest, data.values())
 			if err != nil {
 				continue
 			}
@@ -77,6 +78,12 @@ func registerMethodLines(lookup map[string]struct{}, sourcePackage string, lines
 			}
 		case configDefault:
 			registerFullMethod(lookup, sourcePackage, rest)
+		case configDefaultValue:
+			if _, value, err := parseMethodDefaultValue(rest); err == nil {
+				if _, literal := literalType(value); !literal {
+					registerFullMethod(lookup, sourcePackage, value)
+				}
+			}
 		}
 	}
 }
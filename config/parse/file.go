@@ -1,12 +1,33 @@
 package parse
 
 import (
+	"fmt"
 	"path/filepath"
 	"strings"
+	"text/template"
 )
 
-func File(cwd, rest string) (string, error) {
-	field, err := String(rest)
+// File parses a file path. The path is a text/template executed with data,
+// if data isn't nil. The template is the rest of the line, so that actions
+// may contain spaces like {{ .FileBase }}.
+func File(cwd, rest string, data any) (string, error) {
+	field := strings.TrimSpace(rest)
+	if data != nil && strings.Contains(field, "{{") {
+		tmpl, err := template.New("file").Option("missingkey=error").Parse(field)
+		if err != nil {
+			return "", fmt.Errorf("invalid file template: %s", err)
+		}
+		var b strings.Builder
+		if err := tmpl.Execute(&b, data); err != nil {
+			return "", fmt.Errorf("invalid file template: %s", err)
+		}
+		if b.Len() == 0 {
+			return "", fmt.Errorf("file template %q resolved to an empty path", field)
+		}
+		field = b.String()
+	}
+
+	field, err := String(field)
 	if err != nil {
 		return field, err
 	}
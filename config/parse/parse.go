@@ -3,6 +3,7 @@ package parse
 import (
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 )
 
@@ -54,6 +55,18 @@ func String(remaining string) (string, error) {
 	return fields[0], nil
 }
 
+func Int(remaining string) (int, error) {
+	field, err := String(remaining)
+	if err != nil {
+		return 0, err
+	}
+	value, err := strconv.Atoi(field)
+	if err != nil {
+		return 0, fmt.Errorf("invalid number %q", field)
+	}
+	return value, nil
+}
+
 func Regex(remaining string) (*regexp.Regexp, error) {
 	value, err := String(remaining)
 	if err != nil {
@@ -0,0 +1,124 @@
+// Package diagnostic contains structured goverter errors, that can be rendered
+// as text, json or sarif.
+package diagnostic
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// Severity of a diagnostic.
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+// Diagnostic is an error with structured information about its origin.
+type Diagnostic struct {
+	Severity Severity `json:"severity"`
+	// Message is the human readable message, it's returned by Error.
+	Message string `json:"message"`
+	// Location is the file:line of the setting or method, can be empty.
+	Location string `json:"location,omitempty"`
+	// Setting is the failed setting e.g. goverter:map, can be empty.
+	Setting string `json:"setting,omitempty"`
+	// Cause is the message without location information.
+	Cause string  `json:"cause"`
+	Path  []*Path `json:"path,omitempty"`
+	// Fix is a setting resolving the diagnostic, can be nil.
+	Fix *Fix `json:"fix,omitempty"`
+}
+
+// Fix is a setting that resolves a diagnostic, if it's added to the comments
+// of the method at Location.
+type Fix struct {
+	// Location is the file:line of the method.
+	Location string `json:"location"`
+	// Setting is the setting without the goverter: prefix e.g. ignore Name.
+	Setting string `json:"setting"`
+}
+
+// Path is an element of the conversion path that caused the diagnostic.
+type Path struct {
+	Prefix     string `json:"prefix,omitempty"`
+	SourceID   string `json:"sourceID,omitempty"`
+	SourceType string `json:"sourceType,omitempty"`
+	TargetID   string `json:"targetID,omitempty"`
+	TargetType string `json:"targetType,omitempty"`
+}
+
+// Error returns the human readable message.
+func (d *Diagnostic) Error() string {
+	return d.Message
+}
+
+// AsError returns a copy of the diagnostic with the error severity.
+func (d *Diagnostic) AsError() *Diagnostic {
+	c := *d
+	c.Severity = SeverityError
+	return &c
+}
+
+// FileLine splits the location into file and line. The line is 0 if the
+// location doesn't reference a file.
+func (d *Diagnostic) FileLine() (string, int) {
+	idx := strings.LastIndex(d.Location, ":")
+	if idx == -1 {
+		return "", 0
+	}
+	line, err := strconv.Atoi(d.Location[idx+1:])
+	if err != nil {
+		return "", 0
+	}
+	return d.Location[:idx], line
+}
+
+// FromError returns the diagnostics contained in err. Errors without
+// structured information are converted into a diagnostic containing only the
+// message.
+func FromError(err error) []*Diagnostic {
+	if err == nil {
+		return nil
+	}
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		result := []*Diagnostic{}
+		for _, inner := range joined.Unwrap() {
+			result = append(result, FromError(inner)...)
+		}
+		return result
+	}
+
+	var d *Diagnostic
+	if errors.As(err, &d) {
+		return []*Diagnostic{d}
+	}
+	return []*Diagnostic{{Severity: SeverityError, Message: err.Error(), Cause: err.Error()}}
+}
+
+// Join returns an error containing all errs, nil if errs is empty. The
+// messages are separated by an empty line.
+func Join(errs ...error) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return &joinError{errs: errs}
+}
+
+type joinError struct {
+	errs []error
+}
+
+func (e *joinError) Error() string {
+	messages := make([]string, 0, len(e.errs))
+	for _, err := range e.errs {
+		messages = append(messages, err.Error())
+	}
+	return strings.Join(messages, "\n\n")
+}
+
+func (e *joinError) Unwrap() []error {
+	return e.errs
+}
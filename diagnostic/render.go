@@ -0,0 +1,132 @@
+package diagnostic
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// Format is the output format of diagnostics.
+type Format string
+
+const (
+	FormatText  Format = "text"
+	FormatJSON  Format = "json"
+	FormatSARIF Format = "sarif"
+)
+
+// Render renders the diagnostics in the given format.
+func Render(format Format, diagnostics []*Diagnostic) ([]byte, error) {
+	if diagnostics == nil {
+		diagnostics = []*Diagnostic{}
+	}
+
+	switch format {
+	case FormatText:
+		messages := make([]string, 0, len(diagnostics))
+		for _, d := range diagnostics {
+			messages = append(messages, d.Message)
+		}
+		return []byte(strings.Join(messages, "\n\n")), nil
+	case FormatJSON:
+		return json.MarshalIndent(map[string]interface{}{"diagnostics": diagnostics}, "", "  ")
+	case FormatSARIF:
+		return json.MarshalIndent(toSARIF(diagnostics), "", "  ")
+	default:
+		return nil, fmt.Errorf("unknown format %q", format)
+	}
+}
+
+type sarifLog struct {
+	Version string     `json:"version"`
+	Schema  string     `json:"$schema"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string `json:"name"`
+	InformationURI string `json:"informationUri"`
+}
+
+type sarifResult struct {
+	RuleID     string                 `json:"ruleId"`
+	Level      string                 `json:"level"`
+	Message    sarifMessage           `json:"message"`
+	Locations  []sarifLocation        `json:"locations,omitempty"`
+	Properties map[string]interface{} `json:"properties,omitempty"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           sarifRegion           `json:"region"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine int `json:"startLine"`
+}
+
+func toSARIF(diagnostics []*Diagnostic) *sarifLog {
+	results := []sarifResult{}
+	for _, d := range diagnostics {
+		result := sarifResult{
+			RuleID:  "goverter",
+			Level:   string(d.Severity),
+			Message: sarifMessage{Text: d.Message},
+		}
+		if d.Setting != "" {
+			result.RuleID = d.Setting
+		}
+		if len(d.Path) > 0 {
+			result.Properties = map[string]interface{}{"path": d.Path}
+		}
+		if file, line := d.FileLine(); file != "" {
+			result.Locations = []sarifLocation{{PhysicalLocation: sarifPhysicalLocation{
+				ArtifactLocation: sarifArtifactLocation{URI: fileURI(file)},
+				Region:           sarifRegion{StartLine: line},
+			}}}
+		}
+		results = append(results, result)
+	}
+
+	return &sarifLog{
+		Version: "2.1.0",
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Runs: []sarifRun{{
+			Tool:    sarifTool{Driver: sarifDriver{Name: "goverter", InformationURI: "https://goverter.jmattheis.de"}},
+			Results: results,
+		}},
+	}
+}
+
+func fileURI(file string) string {
+	if !filepath.IsAbs(file) {
+		return filepath.ToSlash(file)
+	}
+	file = filepath.ToSlash(file)
+	if !strings.HasPrefix(file, "/") {
+		file = "/" + file
+	}
+	return "file://" + file
+}
@@ -0,0 +1,111 @@
+package diagnostic
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var UpdateGolden = os.Getenv("UPDATE_GOLDEN") == "true"
+
+var testDiagnostics = []*Diagnostic{
+	{
+		Severity: SeverityError,
+		Message:  "error parsing 'goverter:map' at\n    /work/input.go:7\n\nunknown field Nme",
+		Location: "/work/input.go:7",
+		Setting:  "goverter:map",
+		Cause:    "unknown field Nme",
+		Path: []*Path{{
+			Prefix:     ".",
+			SourceID:   "Nme",
+			SourceType: "???",
+			TargetID:   "Name",
+			TargetType: "string",
+		}},
+		Fix: &Fix{Location: "/work/input.go:8", Setting: "ignore Name"},
+	},
+	{
+		Severity: SeverityWarning,
+		Message:  "goverter:ignoreMissing has no effect",
+		Location: "input.go:3",
+		Setting:  "goverter:ignoreMissing",
+		Cause:    "goverter:ignoreMissing has no effect",
+	},
+	{
+		Severity: SeverityError,
+		Message:  "could not load package",
+		Cause:    "could not load package",
+	},
+}
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		format Format
+		golden string
+	}{
+		{FormatText, "diagnostics.txt"},
+		{FormatJSON, "diagnostics.json"},
+		{FormatSARIF, "diagnostics.sarif"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(string(test.format), func(t *testing.T) {
+			if test.format == FormatSARIF && runtime.GOOS == "windows" {
+				t.Skip("/work/input.go is not an absolute path on windows")
+			}
+			actual, err := Render(test.format, testDiagnostics)
+			require.NoError(t, err)
+
+			golden := filepath.Join("testdata", test.golden)
+			if UpdateGolden {
+				require.NoError(t, os.WriteFile(golden, actual, 0o644))
+			}
+			expected, err := os.ReadFile(golden)
+			require.NoError(t, err)
+			require.Equal(t, string(expected), string(actual))
+		})
+	}
+}
+
+func TestRenderEmpty(t *testing.T) {
+	actual, err := Render(FormatJSON, nil)
+	require.NoError(t, err)
+	require.Equal(t, "{\n  \"diagnostics\": []\n}", string(actual))
+
+	log := toSARIF(nil)
+	require.Len(t, log.Runs, 1)
+	require.NotNil(t, log.Runs[0].Results)
+	require.Empty(t, log.Runs[0].Results)
+}
+
+func TestRenderUnknownFormat(t *testing.T) {
+	_, err := Render("xml", testDiagnostics)
+	require.EqualError(t, err, `unknown format "xml"`)
+}
+
+func TestSARIFSeverity(t *testing.T) {
+	log := toSARIF([]*Diagnostic{
+		{Severity: SeverityError, Message: "a"},
+		{Severity: SeverityWarning, Message: "b"},
+	})
+	require.Equal(t, "error", log.Runs[0].Results[0].Level)
+	require.Equal(t, "warning", log.Runs[0].Results[1].Level)
+	require.Equal(t, "goverter", log.Runs[0].Results[0].RuleID)
+}
+
+func TestFileURI(t *testing.T) {
+	abs, err := filepath.Abs(filepath.Join("work", "pkg", "input.go"))
+	require.NoError(t, err)
+	expectedAbs := "file://" + filepath.ToSlash(abs)
+	if filepath.VolumeName(abs) != "" {
+		expectedAbs = "file:///" + filepath.ToSlash(abs)
+	}
+
+	require.Equal(t, expectedAbs, fileURI(abs))
+	require.Equal(t, "pkg/input.go", fileURI(filepath.Join("pkg", "input.go")))
+	require.Equal(t, "input.go", fileURI("input.go"))
+}
@@ -105,6 +105,7 @@ export default defineConfig({
                   { text: "default", link: "/reference/default" },
                   { text: "ignore", link: "/reference/ignore" },
                   { text: "map", link: "/reference/map" },
+                  { text: "required", link: "/reference/required" },
                   { text: "update", link: "/reference/update" },
                 ],
               },
@@ -118,6 +119,7 @@ export default defineConfig({
                     text: "ignoreUnexported",
                     link: "/reference/ignoreUnexported",
                   },
+                  { text: "list", link: "/reference/list" },
                   {
                     text: "matchIgnoreCase",
                     link: "/reference/matchIgnoreCase",
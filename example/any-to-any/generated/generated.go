@@ -7,6 +7,8 @@ import anytoany "github.com/jmattheis/goverter/example/any-to-any"
 
 type ConverterImpl struct{}
 
+var ConverterConvert = ConverterImpl{}
+
 func (c *ConverterImpl) Convert(source anytoany.Input) anytoany.Output {
 	var exampleOutput anytoany.Output
 	exampleOutput.Value = anytoany.ConvertAny(source.Value)
@@ -7,6 +7,8 @@ import argmapcomplex "github.com/jmattheis/goverter/example/argmap-complex"
 
 type ConverterImpl struct{}
 
+var ConverterConvert = ConverterImpl{}
+
 func (c *ConverterImpl) ConvertTask(source argmapcomplex.Task, status string, priority int, ctx argmapcomplex.Context) argmapcomplex.TaskOutput {
 	var exampleTaskOutput argmapcomplex.TaskOutput
 	exampleTaskOutput.ID = source.ID
@@ -7,6 +7,8 @@ import argmaptypes "github.com/jmattheis/goverter/example/argmap-types"
 
 type ConverterImpl struct{}
 
+var ConverterConvert = ConverterImpl{}
+
 func (c *ConverterImpl) ConvertWithTypes(source argmaptypes.Input, count int32, active bool, score float64) argmaptypes.Output {
 	var exampleOutput argmaptypes.Output
 	exampleOutput.Name = source.Name
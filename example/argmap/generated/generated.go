@@ -7,6 +7,8 @@ import argmap "github.com/jmattheis/goverter/example/argmap"
 
 type ConverterImpl struct{}
 
+var ConverterConvert = ConverterImpl{}
+
 func (c *ConverterImpl) Convert(source argmap.Input, arg2 string, arg3 int) argmap.Output {
 	var exampleOutput argmap.Output
 	exampleOutput.Field1 = source.Field1
@@ -7,6 +7,8 @@ import automap "github.com/jmattheis/goverter/example/auto-map"
 
 type ConverterImpl struct{}
 
+var ConverterConvert = ConverterImpl{}
+
 func (c *ConverterImpl) Convert(source automap.Person) automap.FlatPerson {
 	var exampleFlatPerson automap.FlatPerson
 	exampleFlatPerson.Name = source.Name
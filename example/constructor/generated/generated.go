@@ -10,6 +10,8 @@ import (
 
 type ConverterGlobalMethodImpl struct{}
 
+var ConverterGlobalMethodConvert = ConverterGlobalMethodImpl{}
+
 func (c *ConverterGlobalMethodImpl) Convert(source *constructor.Input) *constructor.Output {
 	var pExampleOutput *constructor.Output
 	if source != nil {
@@ -23,6 +25,8 @@ func (c *ConverterGlobalMethodImpl) Convert(source *constructor.Input) *construc
 
 type ConverterPropertyMethodImpl struct{}
 
+var ConverterPropertyMethodConvert = ConverterPropertyMethodImpl{}
+
 func (c *ConverterPropertyMethodImpl) Convert(source *constructor.Input) *constructor.Output {
 	var pExampleOutput *constructor.Output
 	if source != nil {
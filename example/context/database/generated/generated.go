@@ -7,6 +7,8 @@ import database "github.com/jmattheis/goverter/example/context/database"
 
 type ConverterImpl struct{}
 
+var ConverterConvert = ConverterImpl{}
+
 func (c *ConverterImpl) Convert(source database.PostInput, context database.Database) (database.PostOutput, error) {
 	var examplePostOutput database.PostOutput
 	examplePostOutput.ID = source.ID
@@ -7,6 +7,8 @@ import dateformat "github.com/jmattheis/goverter/example/context/date-format"
 
 type ConverterImpl struct{}
 
+var ConverterConvert = ConverterImpl{}
+
 func (c *ConverterImpl) Convert(source map[string]dateformat.Input, context string) map[string]dateformat.Output {
 	var mapStringExampleOutput map[string]dateformat.Output
 	if source != nil {
@@ -7,6 +7,8 @@ import regex "github.com/jmattheis/goverter/example/context/regex"
 
 type ConverterImpl struct{}
 
+var ConverterConvert = ConverterImpl{}
+
 func (c *ConverterImpl) Convert(source map[string]regex.Input, context string) map[string]regex.Output {
 	var mapStringExampleOutput map[string]regex.Output
 	if source != nil {
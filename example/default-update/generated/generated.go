@@ -7,6 +7,8 @@ import defaultupdate "github.com/jmattheis/goverter/example/default-update"
 
 type ConverterImpl struct{}
 
+var ConverterConvert = ConverterImpl{}
+
 func (c *ConverterImpl) Convert(source *defaultupdate.Input) *defaultupdate.Output {
 	pExampleOutput := defaultupdate.NewOutput()
 	if source != nil {
@@ -7,6 +7,8 @@ import default1 "github.com/jmattheis/goverter/example/default"
 
 type ConverterImpl struct{}
 
+var ConverterConvert = ConverterImpl{}
+
 func (c *ConverterImpl) Convert(source *default1.Input) *default1.Output {
 	pExampleOutput := default1.NewOutput()
 	if source != nil {
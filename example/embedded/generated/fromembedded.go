@@ -7,6 +7,8 @@ import embedded "github.com/jmattheis/goverter/example/embedded"
 
 type FromConverterImpl struct{}
 
+var FromConverterConvert = FromConverterImpl{}
+
 func (c *FromConverterImpl) FromEmbedded(source embedded.Person) embedded.FlatPerson {
 	var exampleFlatPerson embedded.FlatPerson
 	exampleFlatPerson.Name = source.Name
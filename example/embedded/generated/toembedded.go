@@ -7,6 +7,8 @@ import embedded "github.com/jmattheis/goverter/example/embedded"
 
 type ToConverterImpl struct{}
 
+var ToConverterConvert = ToConverterImpl{}
+
 func (c *ToConverterImpl) ToEmbedded(source embedded.FlatPerson) embedded.Person {
 	var examplePerson embedded.Person
 	examplePerson.Address = c.ToEmbeddedAddress(source)
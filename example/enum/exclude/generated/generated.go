@@ -10,6 +10,8 @@ import (
 
 type ConverterImpl struct{}
 
+var ConverterConvert = ConverterImpl{}
+
 func (c *ConverterImpl) Convert(source exclude.MyDuration) time.Duration {
 	return time.Duration(source)
 }
@@ -11,6 +11,8 @@ import (
 
 type ConverterImpl struct{}
 
+var ConverterConvert = ConverterImpl{}
+
 func (c *ConverterImpl) Convert(source input.Color) output.Color {
 	var outputColor output.Color
 	switch source {
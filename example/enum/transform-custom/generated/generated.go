@@ -10,6 +10,8 @@ import (
 
 type ConverterImpl struct{}
 
+var ConverterConvert = ConverterImpl{}
+
 func (c *ConverterImpl) Convert(source example.InputColor) example.OutputColor {
 	var exampleOutputColor example.OutputColor
 	switch source {
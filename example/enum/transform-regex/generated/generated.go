@@ -10,6 +10,8 @@ import (
 
 type ConverterImpl struct{}
 
+var ConverterConvert = ConverterImpl{}
+
 func (c *ConverterImpl) Convert(source transformregex.InputColor) transformregex.OutputColor {
 	var exampleOutputColor transformregex.OutputColor
 	switch source {
@@ -7,6 +7,8 @@ import errors "github.com/jmattheis/goverter/example/errors"
 
 type ConverterImpl struct{}
 
+var ConverterConvert = ConverterImpl{}
+
 func (c *ConverterImpl) ToAPIApartment(source errors.DBApartment) errors.APIApartment {
 	var errorsAPIApartment errors.APIApartment
 	errorsAPIApartment.Position = source.Position
@@ -10,6 +10,8 @@ import (
 
 type ConverterImpl struct{}
 
+var ConverterConvert = ConverterImpl{}
+
 func (c *ConverterImpl) Convert(source extendexternal.Input) (extendexternal.Output, error) {
 	var exampleOutput extendexternal.Output
 	xint, err := strconv.Atoi(source.Value)
@@ -7,6 +7,8 @@ import extendlocalcomplex "github.com/jmattheis/goverter/example/extend-local-co
 
 type ConverterImpl struct{}
 
+var ConverterConvert = ConverterImpl{}
+
 func (c *ConverterImpl) Convert(source []extendlocalcomplex.InputPerson) []extendlocalcomplex.OutputPerson {
 	var exampleOutputPersonList []extendlocalcomplex.OutputPerson
 	if source != nil {
@@ -7,6 +7,8 @@ import extendlocalwithconverter "github.com/jmattheis/goverter/example/extend-lo
 
 type ConverterImpl struct{}
 
+var ConverterConvert = ConverterImpl{}
+
 func (c *ConverterImpl) Convert(source extendlocalwithconverter.Input) extendlocalwithconverter.Output {
 	var exampleOutput extendlocalwithconverter.Output
 	exampleOutput.Animals = extendlocalwithconverter.ConvertAnimals(c, source.Animals)
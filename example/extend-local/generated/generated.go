@@ -7,6 +7,8 @@ import extendlocal "github.com/jmattheis/goverter/example/extend-local"
 
 type ConverterImpl struct{}
 
+var ConverterConvert = ConverterImpl{}
+
 func (c *ConverterImpl) Convert(source extendlocal.Input) extendlocal.Output {
 	var exampleOutput extendlocal.Output
 	exampleOutput.Name = source.Name
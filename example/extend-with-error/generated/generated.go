@@ -7,6 +7,8 @@ import extendwitherror "github.com/jmattheis/goverter/example/extend-with-error"
 
 type ConverterImpl struct{}
 
+var ConverterConvert = ConverterImpl{}
+
 func (c *ConverterImpl) Convert(source extendwitherror.Input) (extendwitherror.Output, error) {
 	var exampleOutput extendwitherror.Output
 	xint, err := extendwitherror.StringToInt(source.Value)
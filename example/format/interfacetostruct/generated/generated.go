@@ -10,6 +10,8 @@ import (
 
 type ConverterImpl struct{}
 
+var ConverterConvert = ConverterImpl{}
+
 func (c *ConverterImpl) ConvertApartment(source common.DBApartment) common.APIApartment {
 	var commonAPIApartment common.APIApartment
 	commonAPIApartment.Position = source.Position
@@ -7,6 +7,8 @@ import house "github.com/jmattheis/goverter/example/house"
 
 type ConverterImpl struct{}
 
+var ConverterConvert = ConverterImpl{}
+
 func (c *ConverterImpl) ConvertApartment(source house.DBApartment) house.APIApartment {
 	var houseAPIApartment house.APIApartment
 	houseAPIApartment.Position = source.Position
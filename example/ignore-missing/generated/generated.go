@@ -7,6 +7,8 @@ import ignoremissing "github.com/jmattheis/goverter/example/ignore-missing"
 
 type ConverterImpl struct{}
 
+var ConverterConvert = ConverterImpl{}
+
 func (c *ConverterImpl) Convert(source ignoremissing.Input) ignoremissing.Output {
 	var exampleOutput ignoremissing.Output
 	exampleOutput.Name = source.Name
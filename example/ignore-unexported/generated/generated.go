@@ -7,6 +7,8 @@ import ignoreunexported "github.com/jmattheis/goverter/example/ignore-unexported
 
 type ConverterImpl struct{}
 
+var ConverterConvert = ConverterImpl{}
+
 func (c *ConverterImpl) Convert(source ignoreunexported.Input) ignoreunexported.Output {
 	var exampleOutput ignoreunexported.Output
 	exampleOutput.Name = source.Name
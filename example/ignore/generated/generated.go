@@ -7,6 +7,8 @@ import ignore "github.com/jmattheis/goverter/example/ignore"
 
 type ConverterImpl struct{}
 
+var ConverterConvert = ConverterImpl{}
+
 func (c *ConverterImpl) Convert(source ignore.Input) ignore.Output {
 	var exampleOutput ignore.Output
 	exampleOutput.Name = source.Name
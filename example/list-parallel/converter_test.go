@@ -0,0 +1,51 @@
+package example_test
+
+import (
+	"strconv"
+	"testing"
+
+	example "github.com/jmattheis/goverter/example/list-parallel"
+	"github.com/jmattheis/goverter/example/list-parallel/generated"
+	"github.com/stretchr/testify/require"
+)
+
+func input(n int) example.Input {
+	source := example.Input{IDs: make([]string, n)}
+	for i := range source.IDs {
+		source.IDs[i] = strconv.Itoa(i)
+	}
+	return source
+}
+
+// The tests run the goroutines of the generated code, run them with
+// go test -race to detect concurrent access to the target.
+func TestConvert(t *testing.T) {
+	var c example.Converter = &generated.ConverterImpl{}
+
+	output, err := c.Convert(input(100))
+	require.NoError(t, err)
+	require.Len(t, output.IDs, 100)
+	for i, id := range output.IDs {
+		require.Equal(t, i, id)
+	}
+}
+
+func TestConvertError(t *testing.T) {
+	var c example.Converter = &generated.ConverterImpl{}
+
+	source := input(100)
+	source.IDs[10] = "a"
+	source.IDs[90] = "b"
+	_, err := c.Convert(source)
+	require.EqualError(t, err, `strconv.Atoi: parsing "a": invalid syntax`)
+}
+
+func TestConvertPanic(t *testing.T) {
+	var c example.Converter = &generated.ConverterImpl{}
+
+	source := input(100)
+	source.IDs[50] = "panic"
+	require.PanicsWithValue(t, "invalid id", func() {
+		_, _ = c.Convert(source)
+	})
+}
@@ -0,0 +1,72 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+//go:build !goverter
+
+package generated
+
+import (
+	listparallel "github.com/jmattheis/goverter/example/list-parallel"
+	"runtime"
+	"sync"
+)
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source listparallel.Input) (listparallel.Output, error) {
+	var exampleOutput listparallel.Output
+	if source.IDs != nil {
+		exampleOutput.IDs = make([]int, len(source.IDs))
+		if len(source.IDs) >= 2 {
+			workers := runtime.GOMAXPROCS(0)
+			chunk := (len(source.IDs) + workers - 1) / workers
+			panics := make([]any, workers)
+			errs := make([]error, workers)
+			var wg sync.WaitGroup
+			for worker := 0; worker*chunk < len(source.IDs); worker++ {
+				start := worker * chunk
+				end := start + chunk
+				if end > len(source.IDs) {
+					end = len(source.IDs)
+				}
+				wg.Add(1)
+				go func(worker, start, end int) {
+					defer wg.Done()
+					defer func() {
+						panics[worker] = recover()
+					}()
+					_, errs[worker] = func() (_ listparallel.Output, _ error) {
+						for i := start; i < end; i++ {
+							xint, err := listparallel.ParseID(source.IDs[i])
+							if err != nil {
+								return exampleOutput, err
+							}
+							exampleOutput.IDs[i] = xint
+						}
+						return
+					}()
+				}(worker, start, end)
+			}
+			wg.Wait()
+			for _, p := range panics {
+				if p != nil {
+					panic(p)
+				}
+			}
+			for _, err := range errs {
+				if err != nil {
+					return exampleOutput, err
+				}
+			}
+		} else {
+			for i := 0; i < len(source.IDs); i++ {
+				xint, err := listparallel.ParseID(source.IDs[i])
+				if err != nil {
+					return exampleOutput, err
+				}
+				exampleOutput.IDs[i] = xint
+			}
+		}
+	}
+	return exampleOutput, nil
+}
@@ -0,0 +1,25 @@
+package example
+
+import "strconv"
+
+// goverter:converter
+// goverter:extend ParseID
+// goverter:list:parallel 2
+type Converter interface {
+	Convert(source Input) (Output, error)
+}
+
+type Input struct {
+	IDs []string
+}
+
+type Output struct {
+	IDs []int
+}
+
+func ParseID(id string) (int, error) {
+	if id == "panic" {
+		panic("invalid id")
+	}
+	return strconv.Atoi(id)
+}
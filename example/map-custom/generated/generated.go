@@ -10,6 +10,8 @@ import (
 
 type ConverterImpl struct{}
 
+var ConverterConvert = ConverterImpl{}
+
 func (c *ConverterImpl) Convert(source mapcustom.Input) (mapcustom.Output, error) {
 	var exampleOutput mapcustom.Output
 	exampleOutput.URL = mapcustom.PrependHTTPS(source.URL)
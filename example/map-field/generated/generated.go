@@ -7,6 +7,8 @@ import mapfield "github.com/jmattheis/goverter/example/map-field"
 
 type ConverterImpl struct{}
 
+var ConverterConvert = ConverterImpl{}
+
 func (c *ConverterImpl) Convert(source mapfield.Input) mapfield.Output {
 	var exampleOutput mapfield.Output
 	exampleOutput.Age = source.Age
@@ -7,6 +7,8 @@ import mapidentity "github.com/jmattheis/goverter/example/map-identity"
 
 type ConverterImpl struct{}
 
+var ConverterConvert = ConverterImpl{}
+
 func (c *ConverterImpl) Convert(source mapidentity.FlatPerson) mapidentity.Person {
 	var examplePerson mapidentity.Person
 	examplePerson.Name = source.Name
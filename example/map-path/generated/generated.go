@@ -7,6 +7,8 @@ import mappath "github.com/jmattheis/goverter/example/map-path"
 
 type ConverterImpl struct{}
 
+var ConverterConvert = ConverterImpl{}
+
 func (c *ConverterImpl) Convert(source mappath.Input) mappath.Output {
 	var exampleOutput mappath.Output
 	exampleOutput.Age = source.Age
@@ -7,6 +7,8 @@ import matchignorecase "github.com/jmattheis/goverter/example/match-ignore-case"
 
 type ConverterImpl struct{}
 
+var ConverterConvert = ConverterImpl{}
+
 func (c *ConverterImpl) Convert(source matchignorecase.Input) matchignorecase.Output {
 	var exampleOutput matchignorecase.Output
 	exampleOutput.Age = source.Age
@@ -7,6 +7,8 @@ import mismatched "github.com/jmattheis/goverter/example/mismatched"
 
 type ConverterImpl struct{}
 
+var ConverterConvert = ConverterImpl{}
+
 func (c *ConverterImpl) Convert(source mismatched.DBCustomers) mismatched.APICustomers {
 	var mismatchedAPICustomers mismatched.APICustomers
 	if source != nil {
@@ -7,6 +7,8 @@ import namestruct "github.com/jmattheis/goverter/example/name-struct"
 
 type RenamedConverter struct{}
 
+var RenamedConverterConvert = RenamedConverter{}
+
 func (c *RenamedConverter) Convert(source namestruct.Input) namestruct.Output {
 	var exampleOutput namestruct.Output
 	exampleOutput.Name = source.Name
@@ -7,6 +7,8 @@ import nestedstruct "github.com/jmattheis/goverter/example/nested-struct"
 
 type ConverterImpl struct{}
 
+var ConverterConvert = ConverterImpl{}
+
 func (c *ConverterImpl) Convert(source nestedstruct.Input) nestedstruct.Output {
 	var exampleOutput nestedstruct.Output
 	exampleOutput.Name = source.Name
@@ -5,6 +5,8 @@ package a
 
 type CIntoAImpl struct{}
 
+var CIntoAConvert = CIntoAImpl{}
+
 func (c *CIntoAImpl) Convert(source []int) []int {
 	var intList []int
 	if source != nil {
@@ -18,6 +20,8 @@ func (c *CIntoAImpl) Convert(source []int) []int {
 
 type RootAImpl struct{}
 
+var RootAConvert = RootAImpl{}
+
 func (c *RootAImpl) Convert(source []bool) []bool {
 	var boolList []bool
 	if source != nil {
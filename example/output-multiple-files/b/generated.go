@@ -5,6 +5,8 @@ package b
 
 type RootBImpl struct{}
 
+var RootBConvert = RootBImpl{}
+
 func (c *RootBImpl) Convert(source []string) []string {
 	var stringList []string
 	if source != nil {
@@ -11,6 +11,8 @@ func Hello() string {
 
 type ConverterImpl struct{}
 
+var ConverterConvert = ConverterImpl{}
+
 func (c *ConverterImpl) Convert(source outputraw.Input) outputraw.Output {
 	var rawOutput outputraw.Output
 	rawOutput.Name = source.Name
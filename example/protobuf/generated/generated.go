@@ -10,6 +10,8 @@ import (
 
 type ConverterImpl struct{}
 
+var ConverterConvert = ConverterImpl{}
+
 func (c *ConverterImpl) FromProtobuf(source *pb.Event) *example.OutputEvent {
 	var pProtobufOutputEvent *example.OutputEvent
 	if source != nil {
@@ -0,0 +1,26 @@
+package example_test
+
+import (
+	"testing"
+
+	example "github.com/jmattheis/goverter/example/required-wrap-errors"
+	"github.com/jmattheis/goverter/example/required-wrap-errors/generated"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequiredError(t *testing.T) {
+	var c example.Converter = &generated.ConverterImpl{}
+
+	_, err := c.Convert(example.Input{Owner: example.Item{ID: 0}})
+	require.EqualError(t, err, "required field Owner.ID is zero")
+
+	_, err = c.Convert(example.Input{
+		Owner:  example.Item{ID: 1},
+		Guests: []example.Item{{ID: 2}, {ID: 0}},
+	})
+	require.EqualError(t, err, "required field Guests[1].ID is zero")
+
+	output, err := c.Convert(example.Input{Owner: example.Item{ID: 1}})
+	require.NoError(t, err)
+	require.Equal(t, example.Output{Owner: example.OutputItem{ID: 1}}, output)
+}
@@ -0,0 +1,32 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+//go:build !goverter
+
+package generated
+
+import (
+	"errors"
+	"fmt"
+	requiredwraperrors "github.com/jmattheis/goverter/example/required-wrap-errors"
+)
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source requiredwraperrors.Input) (requiredwraperrors.Output, error) {
+	var exampleOutput requiredwraperrors.Output
+	exampleOutput.Owner.ID = source.Owner.ID
+	if exampleOutput.Owner.ID == 0 {
+		return exampleOutput, errors.New("required field Owner.ID is zero")
+	}
+	if source.Guests != nil {
+		exampleOutput.Guests = make([]requiredwraperrors.OutputItem, len(source.Guests))
+		for i := 0; i < len(source.Guests); i++ {
+			exampleOutput.Guests[i].ID = source.Guests[i].ID
+			if exampleOutput.Guests[i].ID == 0 {
+				return exampleOutput, fmt.Errorf("required field Guests[%d].ID is zero", i)
+			}
+		}
+	}
+	return exampleOutput, nil
+}
@@ -0,0 +1,23 @@
+package example
+
+// goverter:converter
+// goverter:wrapErrors
+// goverter:requireNonZero ^ID$
+type Converter interface {
+	Convert(source Input) (Output, error)
+}
+
+type Input struct {
+	Owner  Item
+	Guests []Item
+}
+type Item struct {
+	ID int
+}
+type Output struct {
+	Owner  OutputItem
+	Guests []OutputItem
+}
+type OutputItem struct {
+	ID int
+}
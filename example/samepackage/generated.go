@@ -5,6 +5,8 @@ package samepackage
 
 type ConverterImpl struct{}
 
+var ConverterConvert = ConverterImpl{}
+
 func (c *ConverterImpl) Convert(source *Input) *Output {
 	var pSamepackageOutput *Output
 	if source != nil {
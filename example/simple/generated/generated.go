@@ -7,6 +7,8 @@ import simple "github.com/jmattheis/goverter/example/simple"
 
 type ConverterImpl struct{}
 
+var ConverterConvert = ConverterImpl{}
+
 func (c *ConverterImpl) Convert(source []simple.Input) []simple.Output {
 	var simpleOutputList []simple.Output
 	if source != nil {
@@ -7,6 +7,8 @@ import skipcopysametype "github.com/jmattheis/goverter/example/skip-copy-same-ty
 
 type ConverterImpl struct{}
 
+var ConverterConvert = ConverterImpl{}
+
 func (c *ConverterImpl) Convert(source skipcopysametype.Input) skipcopysametype.Output {
 	var exampleOutput skipcopysametype.Output
 	exampleOutput.Name = source.Name
@@ -12,6 +12,8 @@ More detailed
 */
 type MultiLineImpl struct{}
 
+var MultiLineConvert = MultiLineImpl{}
+
 func (c *MultiLineImpl) Convert(source structcomment.Input) structcomment.Output {
 	var exampleOutput structcomment.Output
 	exampleOutput.Name = source.Name
@@ -23,6 +25,8 @@ func (c *MultiLineImpl) Convert(source structcomment.Input) structcomment.Output
 // More detailed
 type MultipleSingleLineImpl struct{}
 
+var MultipleSingleLineConvert = MultipleSingleLineImpl{}
+
 func (c *MultipleSingleLineImpl) Convert(source structcomment.Input) structcomment.Output {
 	var exampleOutput structcomment.Output
 	exampleOutput.Name = source.Name
@@ -32,6 +36,8 @@ func (c *MultipleSingleLineImpl) Convert(source structcomment.Input) structcomme
 // single comment
 type SingleCommentImpl struct{}
 
+var SingleCommentConvert = SingleCommentImpl{}
+
 func (c *SingleCommentImpl) Convert(source structcomment.Input) structcomment.Output {
 	var exampleOutput structcomment.Output
 	exampleOutput.Name = source.Name
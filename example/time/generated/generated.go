@@ -7,6 +7,8 @@ import time "github.com/jmattheis/goverter/example/time"
 
 type ConverterImpl struct{}
 
+var ConverterConvert = ConverterImpl{}
+
 func (c *ConverterImpl) Convert(source time.Input) time.Output {
 	var simpleOutput time.Output
 	simpleOutput.Name = source.Name
@@ -16,6 +18,8 @@ func (c *ConverterImpl) Convert(source time.Input) time.Output {
 
 type ConverterLocallyImpl struct{}
 
+var ConverterLocallyConvert = ConverterLocallyImpl{}
+
 func (c *ConverterLocallyImpl) Convert(source time.Input) time.Output {
 	var simpleOutput time.Output
 	simpleOutput.Name = source.Name
@@ -7,6 +7,8 @@ import updateignorezero "github.com/jmattheis/goverter/example/update-ignore-zer
 
 type WithIgnoreImpl struct{}
 
+var WithIgnoreConvert = WithIgnoreImpl{}
+
 func (c *WithIgnoreImpl) Convert(source updateignorezero.Input, target *updateignorezero.Output) {
 	if source.Name != "" {
 		target.Name = source.Name
@@ -25,6 +27,8 @@ func (c *WithIgnoreImpl) Convert(source updateignorezero.Input, target *updateig
 
 type WithoutIgnoreImpl struct{}
 
+var WithoutIgnoreConvert = WithoutIgnoreImpl{}
+
 func (c *WithoutIgnoreImpl) Convert(source updateignorezero.Input, target *updateignorezero.Output) {
 	target.Name = source.Name
 	target.Age = source.Age
@@ -7,6 +7,8 @@ import update "github.com/jmattheis/goverter/example/update"
 
 type ConverterImpl struct{}
 
+var ConverterConvert = ConverterImpl{}
+
 func (c *ConverterImpl) Convert(source update.Input, target *update.Output) {
 	if source.Name != nil {
 		xstring := *source.Name
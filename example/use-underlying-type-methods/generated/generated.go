@@ -7,6 +7,8 @@ import useunderlyingtypemethods "github.com/jmattheis/goverter/example/use-under
 
 type ConverterImpl struct{}
 
+var ConverterConvert = ConverterImpl{}
+
 func (c *ConverterImpl) Convert(source useunderlyingtypemethods.Input) useunderlyingtypemethods.Output {
 	var exampleOutput useunderlyingtypemethods.Output
 	exampleOutput.ID = useunderlyingtypemethods.OutputID(useunderlyingtypemethods.ConvertUnderlying(int(source.ID)))
@@ -7,6 +7,8 @@ import usezerovalueonpointerinconsistency "github.com/jmattheis/goverter/example
 
 type ConverterImpl struct{}
 
+var ConverterConvert = ConverterImpl{}
+
 func (c *ConverterImpl) Convert(source usezerovalueonpointerinconsistency.Input) usezerovalueonpointerinconsistency.Output {
 	var exampleOutput usezerovalueonpointerinconsistency.Output
 	if source.Name != nil {
@@ -11,6 +11,8 @@ import (
 
 type ConverterImpl struct{}
 
+var ConverterConvert = ConverterImpl{}
+
 func (c *ConverterImpl) Convert(source map[int]example.Input) (map[int]example.Output, error) {
 	var mapIntExampleOutput map[int]example.Output
 	if source != nil {
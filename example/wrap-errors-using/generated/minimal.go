@@ -11,6 +11,8 @@ import (
 
 type MinimalImpl struct{}
 
+var MinimalConvert = MinimalImpl{}
+
 func (c *MinimalImpl) Convert(source map[int]example.Input) (map[int]example.Output, error) {
 	var mapIntExampleOutput map[int]example.Output
 	if source != nil {
@@ -11,6 +11,8 @@ import (
 
 type ConverterImpl struct{}
 
+var ConverterConvert = ConverterImpl{}
+
 func (c *ConverterImpl) Convert(source wraperrors.Input) (wraperrors.Output, error) {
 	var exampleOutput wraperrors.Output
 	xint, err := strconv.Atoi(source.PostalCode)
@@ -0,0 +1,25 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import execution "github.com/jmattheis/goverter/execution"
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source map[string]execution.Input) map[string]execution.Output {
+	var mapStringAliasOutput map[string]execution.Output
+	if source != nil {
+		mapStringAliasOutput = make(map[string]execution.Output, len(source))
+		for key, value := range source {
+			mapStringAliasOutput[key] = c.aliasInputToAliasOutput(value)
+		}
+	}
+	return mapStringAliasOutput
+}
+func (c *ConverterImpl) aliasInputToAliasOutput(source execution.Input) execution.Output {
+	var aliasOutput execution.Output
+	aliasOutput.Name = source.Name
+	return aliasOutput
+}
@@ -0,0 +1,12 @@
+package alias
+
+// goverter:converter
+type Converter interface {
+    Convert(source InputAlias) OutputAlias
+}
+
+type InputAlias = map[string]Input
+type OutputAlias = map[string]Output
+
+type Input struct { Name string }
+type Output struct { Name string }
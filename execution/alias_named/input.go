@@ -0,0 +1,12 @@
+package alias
+
+// goverter:converter
+type Converter interface {
+    Convert(source InputAlias) OutputAlias
+}
+
+type InputAlias = Input
+type OutputAlias = Output
+
+type Input struct { Name string }
+type Output struct { Name string }
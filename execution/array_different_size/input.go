@@ -0,0 +1,6 @@
+package slices_arrays
+
+// goverter:converter
+type Converter interface {
+    Convert(source [6]int) [5]int
+}
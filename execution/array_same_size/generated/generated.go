@@ -0,0 +1,29 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import execution "github.com/jmattheis/goverter/execution"
+
+type Converter2Impl struct{}
+
+var Converter2Convert = Converter2Impl{}
+
+func (c *Converter2Impl) Convert(source execution.Data) execution.Data {
+	var slices_arraysData execution.Data
+	for i := 0; i < len(source.B); i++ {
+		slices_arraysData.B[i] = source.B[i]
+	}
+	return slices_arraysData
+}
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source [5]int) [5]int {
+	var intList [5]int
+	for i := 0; i < len(source); i++ {
+		intList[i] = source[i]
+	}
+	return intList
+}
@@ -0,0 +1,15 @@
+package slices_arrays
+
+// goverter:converter
+type Converter interface {
+    Convert(source [5]int) [5]int
+}
+
+// goverter:converter
+type Converter2 interface {
+    Convert(source Data) Data
+}
+
+type Data struct {
+    B [16]byte
+}
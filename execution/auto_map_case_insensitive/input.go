@@ -0,0 +1,24 @@
+package example
+
+// goverter:converter
+type Converter interface {
+    // goverter:autoMap Address
+    // goverter:matchIgnoreCase
+    Convert(Person) FlatPerson
+}
+
+type FlatPerson struct {
+    Name    string
+    Age     int
+    STREET  string
+    ZipCode string
+}
+type Person struct {
+    Name    string
+    Age     int
+    Address Address
+}
+type Address struct {
+    Street  string
+    ZipCode string
+}
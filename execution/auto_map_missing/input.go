@@ -0,0 +1,24 @@
+package example
+
+// goverter:converter
+type Converter interface {
+    // goverter:autoMap Abc
+    Convert(Person) FlatPerson
+}
+
+type FlatPerson struct {
+    Name    string
+    Age     int
+    Street  string
+    ZipCode string
+}
+type Person struct {
+    Name    string
+    Age     int
+    Address Address
+    Street  string
+}
+type Address struct {
+    Street  string
+    ZipCode string
+}
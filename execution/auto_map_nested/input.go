@@ -0,0 +1,26 @@
+package example
+
+// goverter:converter
+type Converter interface {
+    // goverter:autoMap Nested.Address
+    Convert(Person) FlatPerson
+}
+
+type FlatPerson struct {
+    Name    string
+    Age     int
+    Street  string
+    ZipCode string
+}
+type Person struct {
+    Name    string
+    Age     int
+    Nested  Nested
+}
+type Nested struct {
+    Address Address
+}
+type Address struct {
+    Street  string
+    ZipCode string
+}
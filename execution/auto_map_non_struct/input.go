@@ -0,0 +1,19 @@
+package example
+
+// goverter:converter
+type Converter interface {
+    // goverter:autoMap Address
+    Convert(Person) FlatPerson
+}
+
+type FlatPerson struct {
+    Name    string
+    Age     int
+    Street  string
+    ZipCode string
+}
+type Person struct {
+    Name    string
+    Age     int
+    Address string
+}
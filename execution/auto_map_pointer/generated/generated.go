@@ -0,0 +1,24 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import execution "github.com/jmattheis/goverter/execution"
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source execution.Person) execution.FlatPerson {
+	var exampleFlatPerson execution.FlatPerson
+	exampleFlatPerson.Name = source.Name
+	exampleFlatPerson.Age = source.Age
+	var pString *string
+	if source.Address != nil {
+		pString = &source.Address.Street
+	}
+	if pString != nil {
+		xstring := *pString
+		exampleFlatPerson.Street = &xstring
+	}
+	return exampleFlatPerson
+}
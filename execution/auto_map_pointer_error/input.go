@@ -0,0 +1,21 @@
+package example
+
+// goverter:converter
+type Converter interface {
+    // goverter:autoMap Address
+    Convert(Person) FlatPerson
+}
+
+type FlatPerson struct {
+    Name    string
+    Age     int
+    Street  string
+}
+type Person struct {
+    Name    string
+    Age     int
+    Address *Address
+}
+type Address struct {
+    Street  string
+}
@@ -0,0 +1,10 @@
+package structs
+
+// goverter:converter
+// goverter:useZeroValueOnPointerInconsistency abc
+type Converter interface {
+    Convert(Input) Output
+}
+
+type Input struct { Name string }
+type Output struct { Name string }
@@ -0,0 +1,10 @@
+package structs
+
+// goverter:converter
+// goverter:useZeroValueOnPointerInconsistency 1 2
+type Converter interface {
+    Convert(Input) Output
+}
+
+type Input struct { Name string }
+type Output struct { Name string }
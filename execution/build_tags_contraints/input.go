@@ -0,0 +1,22 @@
+package structs
+
+// goverter:converter
+// goverter:output:file ./generated.go
+// goverter:output:package github.com/jmattheis/goverter/execution:structs
+type Converter interface {
+    Convert(source Input) Output
+}
+
+type Input struct {
+    name string
+    Age int
+    ignored string
+}
+func (Input) method() bool {
+    return true
+}
+type Output struct {
+    name string
+    method bool
+    Age int
+}
@@ -0,0 +1,29 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) A(source chan int) chan int {
+	return source
+}
+func (c *ConverterImpl) B(source <-chan int) <-chan int {
+	return source
+}
+func (c *ConverterImpl) C(source chan<- int) chan<- int {
+	return source
+}
+func (c *ConverterImpl) D(source chan<- <-chan int) chan<- <-chan int {
+	return source
+}
+func (c *ConverterImpl) E(source <-chan <-chan int) <-chan <-chan int {
+	return source
+}
+func (c *ConverterImpl) F(source chan chan int) chan chan int {
+	return source
+}
+func (c *ConverterImpl) G(source chan (<-chan int)) chan (<-chan int) {
+	return source
+}
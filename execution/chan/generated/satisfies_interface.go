@@ -0,0 +1,5 @@
+package generated
+
+import execution "github.com/jmattheis/goverter/execution"
+
+var _ execution.Converter = (*ConverterImpl)(nil)
@@ -0,0 +1,13 @@
+package chans
+
+// goverter:converter
+// goverter:skipCopySameType
+type Converter interface {
+    A(chan int) chan int
+    B(<-chan int) <-chan int
+    C(chan<- int) chan<- int
+    D(chan<- <-chan int) chan<- <-chan int
+    E(<-chan <-chan int) <-chan <-chan int
+    F(chan chan int) chan chan int
+    G(chan (<-chan int)) chan (<-chan int)
+}
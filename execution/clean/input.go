@@ -0,0 +1,15 @@
+package structs
+
+// goverter:converter
+// goverter:output:file ./generated/converter.go
+type Converter interface {
+    Convert(source Input) Output
+}
+
+type Input struct {
+    Name string
+}
+
+type Output struct {
+    Name string
+}
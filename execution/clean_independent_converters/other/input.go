@@ -0,0 +1,8 @@
+package other
+
+import structs "github.com/jmattheis/goverter/execution"
+
+// goverter:converter
+type Converter interface {
+    Convert(source structs.Input) string
+}
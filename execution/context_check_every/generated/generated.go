@@ -0,0 +1,50 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	execution "github.com/jmattheis/goverter/execution"
+)
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) ConvertList(ctx context.Context, source []execution.Input) ([]execution.Output, error) {
+	var structsOutputList []execution.Output
+	if source != nil {
+		structsOutputList = make([]execution.Output, len(source))
+		for i := 0; i < len(source); i++ {
+			if i%1000 == 0 {
+				if err := ctx.Err(); err != nil {
+					return nil, err
+				}
+			}
+			structsOutputList[i] = c.structsInputToStructsOutput(source[i])
+		}
+	}
+	return structsOutputList, nil
+}
+func (c *ConverterImpl) ConvertMap(ctx context.Context, source map[string]execution.Input) (map[string]execution.Output, error) {
+	var mapStringStructsOutput map[string]execution.Output
+	if source != nil {
+		mapStringStructsOutput = make(map[string]execution.Output, len(source))
+		i := 0
+		for key, value := range source {
+			if i%1000 == 0 {
+				if err := ctx.Err(); err != nil {
+					return nil, err
+				}
+			}
+			i++
+			mapStringStructsOutput[key] = c.structsInputToStructsOutput(value)
+		}
+	}
+	return mapStringStructsOutput, nil
+}
+func (c *ConverterImpl) structsInputToStructsOutput(source execution.Input) execution.Output {
+	var structsOutput execution.Output
+	structsOutput.Name = source.Name
+	return structsOutput
+}
@@ -0,0 +1,18 @@
+package structs
+
+import "context"
+
+// goverter:converter
+// goverter:ctx:checkEvery 1000
+type Converter interface {
+    ConvertList(ctx context.Context, source []Input) ([]Output, error)
+    ConvertMap(ctx context.Context, source map[string]Input) (map[string]Output, error)
+}
+
+type Input struct {
+    Name string
+}
+
+type Output struct {
+    Name string
+}
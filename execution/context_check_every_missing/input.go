@@ -0,0 +1,15 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:ctx:checkEvery 1000
+    ConvertList(source []Input) ([]Output, error)
+}
+
+type Input struct {
+    Name string
+}
+
+type Output struct {
+    Name string
+}
@@ -0,0 +1,17 @@
+package structs
+
+import "context"
+
+// goverter:converter
+type Converter interface {
+    // goverter:ctx:checkEvery 1000
+    ConvertList(ctx context.Context, source []Input) []Output
+}
+
+type Input struct {
+    Name string
+}
+
+type Output struct {
+    Name string
+}
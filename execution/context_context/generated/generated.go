@@ -0,0 +1,31 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	execution "github.com/jmattheis/goverter/execution"
+)
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(ctx context.Context, source execution.Input) (execution.Output, error) {
+	var structsOutput execution.Output
+	structsOutputNested, err := c.structsInputNestedToStructsOutputNested(source.Nested, ctx)
+	if err != nil {
+		return structsOutput, err
+	}
+	structsOutput.Nested = structsOutputNested
+	return structsOutput, nil
+}
+func (c *ConverterImpl) structsInputNestedToStructsOutputNested(source execution.InputNested, ctx context.Context) (execution.OutputNested, error) {
+	var structsOutputNested execution.OutputNested
+	xstring, err := execution.LookupName(ctx, source.Name)
+	if err != nil {
+		return structsOutputNested, err
+	}
+	structsOutputNested.Name = xstring
+	return structsOutputNested, nil
+}
@@ -0,0 +1,29 @@
+package structs
+
+import "context"
+
+// goverter:converter
+// goverter:extend LookupName
+type Converter interface {
+    Convert(ctx context.Context, source Input) (Output, error)
+}
+
+func LookupName(ctx context.Context, id int) (string, error) {
+    return "", ctx.Err()
+}
+
+type Input struct {
+    Nested InputNested
+}
+
+type InputNested struct {
+    Name int
+}
+
+type Output struct {
+    Nested OutputNested
+}
+
+type OutputNested struct {
+    Name string
+}
@@ -0,0 +1,19 @@
+package example
+
+// goverter:converter
+// goverter:extend DoLookup
+type Converter interface {
+    // goverter:context ctx1
+    // goverter:context ctx2
+    Convert(source []string, ctx1 map[string]Output, ctx2 map[string]Output) []Output
+}
+
+// goverter:context ctx
+func DoLookup(id string, ctx map[string]Output) Output {
+    return ctx[id]
+}
+
+type Output struct {
+    Name string
+    Age int
+}
@@ -0,0 +1,17 @@
+package example
+
+// goverter:converter
+// goverter:extend DoLookup
+type Converter interface {
+    Convert(source []string) []Output
+}
+
+// goverter:context ctx
+func DoLookup(id string, ctx map[string]Output) Output {
+    return ctx[id]
+}
+
+type Output struct {
+    Name string
+    Age int
+}
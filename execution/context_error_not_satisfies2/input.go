@@ -0,0 +1,24 @@
+package example
+
+// goverter:converter
+// goverter:extend DoLookup
+// goverter:extend DoOther
+type Converter interface {
+    // goverter:context ctx
+    Convert(source []string, ctx map[string]int) []Output
+}
+
+// goverter:context ctx
+func DoLookup(id string, ctx map[string]Output) Output {
+    return ctx[id]
+}
+
+// goverter:context ctx
+func DoOther(id string, ctx map[string]string) Output {
+    return Output{}
+}
+
+type Output struct {
+    Name string
+    Age int
+}
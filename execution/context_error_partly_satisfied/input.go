@@ -0,0 +1,19 @@
+package example
+
+// goverter:converter
+// goverter:extend DoLookup
+type Converter interface {
+    // goverter:context ctx
+    Convert(source []string, ctx map[string]Output) []Output
+}
+
+// goverter:context ctx
+// goverter:context ctx2
+func DoLookup(id string, ctx map[string]Output, ctx2 map[string]int) Output {
+    return ctx[id]
+}
+
+type Output struct {
+    Name string
+    Age int
+}
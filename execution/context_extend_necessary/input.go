@@ -0,0 +1,40 @@
+package example
+
+// goverter:converter
+// goverter:extend LookupInt
+// goverter:extend LookupBool
+type Converter interface {
+    // goverter:context ctxInt
+    // goverter:context ctxBool
+    Convert(source []Input, ctxInt map[string]int, ctxBool map[string]bool) []Output
+}
+
+// goverter:context ctx
+func LookupBool(id string, ctx map[string]bool) bool {
+    return ctx[id]
+}
+
+// goverter:context ctx
+func LookupInt(id string, ctx map[string]int) int {
+    return ctx[id]
+}
+
+type Input struct {
+    One InputWrap
+    Two InputWrap
+}
+type InputWrap struct {
+    Value string
+}
+
+type Output struct {
+    One OutputOne
+    Two OutputTwo
+}
+
+type OutputOne struct {
+    Value bool
+}
+type OutputTwo struct {
+    Value int
+}
@@ -0,0 +1,18 @@
+package example
+
+// goverter:converter
+// goverter:extend DoLookup
+type Converter interface {
+    // goverter:context ctxLookup
+    Convert(source []string, ctxLookup map[string]Output) []Output
+}
+
+// goverter:context ctx
+func DoLookup(id string, ctx map[string]Output) Output {
+    return ctx[id]
+}
+
+type Output struct {
+    Name string
+    Age int
+}
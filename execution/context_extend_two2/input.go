@@ -0,0 +1,20 @@
+package example
+
+// goverter:converter
+// goverter:extend DoLookup
+type Converter interface {
+    // goverter:context ctxOther
+    // goverter:context ctxLookup
+    Convert(source []string, ctxOther map[string]int, ctxLookup map[string]Output) []Output
+}
+
+// goverter:context ctx
+// goverter:context ctx2
+func DoLookup(ctx map[string]Output, id string, ctx2 map[string]int) Output {
+    return ctx[id]
+}
+
+type Output struct {
+    Name string
+    Age int
+}
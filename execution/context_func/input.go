@@ -0,0 +1,23 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:context format
+    // goverter:map Name Title | Format
+    Convert(source Input, format func(string) string) Output
+}
+
+// goverter:context format
+func Format(name string, format func(string) string) string {
+    return format(name)
+}
+
+type Input struct {
+    Name string
+    Nick string
+}
+
+type Output struct {
+    Title string
+    Nick  string
+}
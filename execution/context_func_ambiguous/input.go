@@ -0,0 +1,16 @@
+package structs
+
+// goverter:converter
+type Converter[T any] interface {
+    // goverter:context convert
+    // goverter:context convertErr
+    Convert(source Input[T], convert func(T) string, convertErr func(T) (string, error)) (Output, error)
+}
+
+type Input[T any] struct {
+    Value T
+}
+
+type Output struct {
+    Value string
+}
@@ -0,0 +1,23 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import execution "github.com/jmattheis/goverter/execution"
+
+type ConverterImpl[T any] struct{}
+
+func (c *ConverterImpl[T]) Convert(source execution.Input[T], context func(execution.Item[T]) execution.ItemOut) execution.Output {
+	var structsOutput execution.Output
+	if source.Items != nil {
+		structsOutput.Items = make([]execution.ItemOut, len(source.Items))
+		for i := 0; i < len(source.Items); i++ {
+			structsOutput.Items[i] = c.ConvertItem(source.Items[i])
+		}
+	}
+	return structsOutput
+}
+func (c *ConverterImpl[T]) ConvertItem(source execution.Item[T]) execution.ItemOut {
+	var structsItemOut execution.ItemOut
+	structsItemOut.ID = source.ID
+	return structsItemOut
+}
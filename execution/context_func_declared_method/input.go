@@ -0,0 +1,25 @@
+package structs
+
+// goverter:converter
+type Converter[T any] interface {
+    // goverter:context convertItem
+    Convert(source Input[T], convertItem func(Item[T]) ItemOut) Output
+    ConvertItem(source Item[T]) ItemOut
+}
+
+type Input[T any] struct {
+    Items []Item[T]
+}
+
+type Output struct {
+    Items []ItemOut
+}
+
+type Item[T any] struct {
+    ID    int
+    Value T
+}
+
+type ItemOut struct {
+    ID int
+}
@@ -0,0 +1,23 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:context ctx
+    Convert(source DBModel, ctx string) ApiModel
+}
+
+type DBModel struct {
+    Inner DBInner
+}
+type DBInner struct {
+    Name string
+    Value int
+}
+
+type ApiModel struct {
+    Inner ApiInner
+}
+type ApiInner struct {
+    Name string
+    Value int
+}
@@ -0,0 +1,35 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import execution "github.com/jmattheis/goverter/execution"
+
+type Conv1Impl struct{}
+
+var Conv1Convert = Conv1Impl{}
+
+func (c *Conv1Impl) Convert(source []string, context map[string]execution.Output) []execution.Output {
+	var exampleOutputList []execution.Output
+	if source != nil {
+		exampleOutputList = make([]execution.Output, len(source))
+		for i := 0; i < len(source); i++ {
+			exampleOutputList[i] = execution.One(source[i], context)
+		}
+	}
+	return exampleOutputList
+}
+
+type Conv2Impl struct{}
+
+var Conv2Convert = Conv2Impl{}
+
+func (c *Conv2Impl) Convert(source []string, context map[string]execution.Output) []execution.Output {
+	var exampleOutputList []execution.Output
+	if source != nil {
+		exampleOutputList = make([]execution.Output, len(source))
+		for i := 0; i < len(source); i++ {
+			exampleOutputList[i] = execution.Two(source[i])
+		}
+	}
+	return exampleOutputList
+}
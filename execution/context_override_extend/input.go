@@ -0,0 +1,23 @@
+package example
+
+// goverter:converter
+// goverter:extend Two
+// goverter:extend One
+type Conv1 interface {
+    // goverter:context ctx
+    Convert(source []string, ctx map[string]Output) []Output
+}
+
+// goverter:converter
+// goverter:extend One
+// goverter:extend Two
+type Conv2 interface {
+    // goverter:context ctx
+    Convert(source []string, ctx map[string]Output) []Output
+}
+
+// goverter:context ctx
+func One(id string, ctx map[string]Output) Output { return ctx[id] }
+func Two(id string) Output { return Output{} }
+
+type Output struct {}
@@ -0,0 +1,17 @@
+package example
+
+// goverter:converter
+// goverter:arg:context:regex test
+// goverter:extend DoLookup
+type Converter interface {
+    Convert(source []string, test map[string]Output) []Output
+}
+
+func DoLookup(id string, test map[string]Output) Output {
+    return test[id]
+}
+
+type Output struct {
+    Name string
+    Age int
+}
@@ -0,0 +1,14 @@
+package pkg1
+
+// goverter:converter
+// goverter:output:file @cwd/generated/output.go
+type Converter interface {
+    Convert(source Input) Output
+}
+
+type Input struct {
+    ID int
+}
+type Output struct {
+    ID int
+}
@@ -0,0 +1,7 @@
+package external
+
+import "github.com/jmattheis/goverter/execution"
+
+func NewOutput() execution.Output {
+    return execution.Output{}
+}
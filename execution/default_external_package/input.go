@@ -0,0 +1,14 @@
+package execution
+
+// goverter:converter
+type Converter interface {
+    // goverter:default github.com/jmattheis/goverter/execution/external:NewOutput
+    Convert(source Input) Output
+}
+
+type Input struct {
+    ID int
+}
+type Output struct {
+    ID int
+}
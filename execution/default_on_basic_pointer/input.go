@@ -0,0 +1,16 @@
+package execution
+
+// goverter:converter
+type Converter interface {
+    // goverter:default NewStringP
+    One(source string) *string
+
+    // goverter:default NewStringP
+    Two(source *string) *string
+
+    // goverter:default NewString
+    // goverter:useZeroValueOnPointerInconsistency
+    Three(source *string) string
+}
+func NewStringP() *string { return nil }
+func NewString() string { return "" }
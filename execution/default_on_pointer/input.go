@@ -0,0 +1,20 @@
+package execution
+
+// goverter:converter
+type Default interface {
+    // goverter:default NewOutputWithDefaults
+    Convert(source *Input) (*Output, error)
+}
+
+// goverter:converter
+type Update interface {
+    // goverter:default NewOutputWithDefaults
+    // goverter:default:update
+    Update(source *Input) (*Output, error)
+}
+type Input struct {  Name string }
+type Output struct { Name string }
+
+func NewOutputWithDefaults() *Output {
+    return &Output{ Name: "string" }
+}
@@ -0,0 +1,16 @@
+package execution
+
+// goverter:converter
+type Converter interface {
+    // goverter:default NewOutputWithDefaults
+    // goverter:default:update
+    Convert(source *Input) (*Output, error)
+}
+type Input struct { Name int }
+type Output struct { Name string }
+
+func NewOutputWithDefaults(string) *Output {
+    return &Output{
+        Name: "string",
+    }
+}
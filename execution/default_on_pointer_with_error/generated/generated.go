@@ -0,0 +1,37 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import execution "github.com/jmattheis/goverter/execution"
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source *execution.Input) (*execution.Output, error) {
+	pExecutionOutput, err := execution.NewOutput()
+	if err != nil {
+		return nil, err
+	}
+	if source != nil {
+		var executionOutput execution.Output
+		executionOutput.ID = (*source).ID
+		pExecutionOutput = &executionOutput
+	}
+	return pExecutionOutput, nil
+}
+
+type UpdateImpl struct{}
+
+var UpdateConvert = UpdateImpl{}
+
+func (c *UpdateImpl) Update(source *execution.Input) (*execution.Output, error) {
+	pExecutionOutput, err := execution.NewOutput()
+	if err != nil {
+		return nil, err
+	}
+	if source != nil {
+		(*pExecutionOutput).ID = (*source).ID
+	}
+	return pExecutionOutput, nil
+}
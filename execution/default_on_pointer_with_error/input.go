@@ -0,0 +1,20 @@
+package execution
+
+// goverter:converter
+type Converter interface {
+    // goverter:default NewOutput
+    Convert(source *Input) (*Output, error)
+}
+
+// goverter:converter
+type Update interface {
+    // goverter:default NewOutput
+    // goverter:default:update
+    Update(source *Input) (*Output, error)
+}
+
+type Input struct { ID int }
+type Output struct { ID int }
+func NewOutput() (*Output, error) {
+    return &Output{}, nil
+}
@@ -0,0 +1,39 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import execution "github.com/jmattheis/goverter/execution"
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source *execution.Input) (*execution.Output, error) {
+	executionOutput, err := execution.NewOutput()
+	if err != nil {
+		return nil, err
+	}
+	pExecutionOutput := &executionOutput
+	if source != nil {
+		var executionOutput2 execution.Output
+		executionOutput2.Name = (*source).Name
+		pExecutionOutput = &executionOutput2
+	}
+	return pExecutionOutput, nil
+}
+
+type UpdateImpl struct{}
+
+var UpdateConvert = UpdateImpl{}
+
+func (c *UpdateImpl) Update(source *execution.Input) (*execution.Output, error) {
+	executionOutput, err := execution.NewOutput()
+	if err != nil {
+		return nil, err
+	}
+	pExecutionOutput := &executionOutput
+	if source != nil {
+		(*pExecutionOutput).Name = (*source).Name
+	}
+	return pExecutionOutput, nil
+}
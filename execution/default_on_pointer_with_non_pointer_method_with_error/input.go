@@ -0,0 +1,21 @@
+package execution
+
+// goverter:converter
+type Converter interface {
+    // goverter:default NewOutput
+    Convert(source *Input) (*Output, error)
+}
+
+// goverter:converter
+type Update interface {
+    // goverter:default NewOutput
+    // goverter:default:update
+    Update(source *Input) (*Output, error)
+}
+
+type Input struct { Name string }
+type Output struct { Name string }
+
+func NewOutput() (Output, error) {
+    return Output{Name: "string"}, nil
+}
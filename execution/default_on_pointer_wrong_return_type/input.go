@@ -0,0 +1,20 @@
+package execution
+
+// goverter:converter
+type Converter interface {
+    // goverter:default NewOutput
+    Convert(source *Input) *Output
+}
+
+type Input struct {
+    ID int
+}
+type Output struct {
+    ID int
+}
+type Wrong struct {
+    ID int
+}
+func NewOutput() Wrong {
+    return Wrong{}
+}
@@ -0,0 +1,43 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import execution "github.com/jmattheis/goverter/execution"
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source *execution.Input) (execution.Output, error) {
+	executionOutput := execution.NewOutputWithDefaults()
+	if source != nil {
+		executionOutput.Name = (*source).Name
+	}
+	return executionOutput, nil
+}
+
+type UpdateImpl struct{}
+
+var UpdateConvert = UpdateImpl{}
+
+func (c *UpdateImpl) Update(source *execution.Input) (execution.Output, error) {
+	executionOutput := execution.NewOutputWithDefaults()
+	if source != nil {
+		executionOutput.Name = (*source).Name
+	}
+	return executionOutput, nil
+}
+
+type UpdateUpdateImpl struct{}
+
+var UpdateUpdateConvert = UpdateUpdateImpl{}
+
+func (c *UpdateUpdateImpl) Update(source *execution.Input) (execution.Output, error) {
+	executionOutput := execution.NewOutputWithDefaults()
+	if source != nil {
+		if (*source).Name != "" {
+			executionOutput.Name = (*source).Name
+		}
+	}
+	return executionOutput, nil
+}
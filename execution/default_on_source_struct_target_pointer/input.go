@@ -0,0 +1,31 @@
+package execution
+
+// goverter:converter
+type Converter interface {
+    // goverter:default NewOutputWithDefaults
+    // goverter:useZeroValueOnPointerInconsistency
+    Convert(source *Input) (Output, error)
+}
+
+// goverter:converter
+type Update interface {
+    // goverter:default NewOutputWithDefaults
+    // goverter:default:update
+    // goverter:useZeroValueOnPointerInconsistency
+    Update(source *Input) (Output, error)
+}
+
+// goverter:converter
+type UpdateUpdate interface {
+    // goverter:default NewOutputWithDefaults
+    // goverter:default:update
+    // goverter:update:ignoreZeroValueField
+    // goverter:useZeroValueOnPointerInconsistency
+    Update(source *Input) (Output, error)
+}
+type Input struct { Name string }
+type Output struct { Name string }
+
+func NewOutputWithDefaults() Output {
+    return Output{ Name: "string" }
+}
@@ -0,0 +1,14 @@
+package execution
+
+// goverter:converter
+type Converter interface {
+    // goverter:default NewOutputWithDefaults
+    // goverter:useZeroValueOnPointerInconsistency
+    Convert(source *Input) (Output, error)
+}
+type Input struct { Name string }
+type Output struct { Name string }
+
+func NewOutputWithDefaults() string {
+    return ""
+}
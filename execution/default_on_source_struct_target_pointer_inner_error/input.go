@@ -0,0 +1,20 @@
+package execution
+
+// goverter:converter
+type Converter interface {
+    // goverter:default NewOutputWithDefaults
+    // goverter:useZeroValueOnPointerInconsistency
+    Convert(source *Input) (Output, error)
+}
+type Input struct {
+    Name       int
+}
+type Output struct {
+    Name       string
+}
+
+func NewOutputWithDefaults() Output {
+    return Output{
+        Name: "string",
+    }
+}
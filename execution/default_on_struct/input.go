@@ -0,0 +1,24 @@
+package execution
+
+// goverter:converter
+type Converter interface {
+    // goverter:default NewOutput
+    // goverter:ignore ID
+    Convert(source Input) Output
+}
+
+type Input struct {
+    ID int
+    FirstName string
+    LastName string
+}
+type Output struct {
+    ID int
+    FirstName string
+    LastName string
+}
+func NewOutput() Output {
+    return Output{
+        ID: 0,
+    }
+}
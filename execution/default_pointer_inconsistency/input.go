@@ -0,0 +1,22 @@
+package execution
+
+// goverter:converter
+// goverter:useZeroValueOnPointerInconsistency
+type Converter interface {
+    // goverter:default NewOutput
+    Convert(source *Input) Output
+}
+
+// goverter:converter
+// goverter:useZeroValueOnPointerInconsistency
+type Update interface {
+    // goverter:default NewOutput
+    // goverter:default:update
+    ConvertUpdate(source *Input) Output
+}
+
+type Input struct { ID int }
+type Output struct { ID int }
+func NewOutput() Output {
+    return Output{ ID: 0 }
+}
@@ -0,0 +1,18 @@
+package execution
+
+// goverter:converter
+// goverter:useZeroValueOnPointerInconsistency
+type Converter interface {
+    // goverter:default NewOutput
+    Convert(source *Input) Output
+}
+
+type Input struct {
+    ID int
+}
+type Output struct {
+    ID int
+}
+func NewOutput() string {
+    return ""
+}
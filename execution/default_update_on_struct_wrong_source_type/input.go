@@ -0,0 +1,18 @@
+package execution
+
+// goverter:converter
+type Converter interface {
+    // goverter:default NewOutput
+    // goverter:default:update
+    Convert(source Input) Output
+}
+
+type Input struct {
+    ID int
+}
+type Output struct {
+    ID int
+}
+func NewOutput(string) Output {
+    return Output{}
+}
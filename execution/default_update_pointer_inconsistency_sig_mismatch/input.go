@@ -0,0 +1,19 @@
+package execution
+
+// goverter:converter
+// goverter:useZeroValueOnPointerInconsistency
+// goverter:default:update
+type Converter interface {
+    // goverter:default NewOutput
+    Convert(source *Input) Output
+}
+
+type Input struct {
+    ID int
+}
+type Output struct {
+    ID int
+}
+func NewOutput() string {
+    return ""
+}
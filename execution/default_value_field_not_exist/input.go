@@ -0,0 +1,14 @@
+package execution
+
+// goverter:converter
+type Converter interface {
+    // goverter:default:value Limt 10
+    Convert(source Input) Output
+}
+
+type Input struct {
+    Limit *int
+}
+type Output struct {
+    Limit *int
+}
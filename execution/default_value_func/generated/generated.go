@@ -0,0 +1,29 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import execution "github.com/jmattheis/goverter/execution"
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source execution.Input) (execution.Output, error) {
+	var executionOutput execution.Output
+	if source.CreatedAt != nil {
+		executionOutput.CreatedAt = *source.CreatedAt
+	} else {
+		executionOutput.CreatedAt = execution.Now()
+	}
+	if source.Name != nil {
+		xstring := *source.Name
+		executionOutput.Name = &xstring
+	} else {
+		pString, err := execution.DefaultName()
+		if err != nil {
+			return executionOutput, err
+		}
+		executionOutput.Name = pString
+	}
+	return executionOutput, nil
+}
@@ -0,0 +1,26 @@
+package execution
+
+// goverter:converter
+type Converter interface {
+    // goverter:default:value CreatedAt Now
+    // goverter:default:value Name DefaultName
+    Convert(source Input) (Output, error)
+}
+
+func Now() int64 {
+    return 42
+}
+
+func DefaultName() (*string, error) {
+    name := "unknown"
+    return &name, nil
+}
+
+type Input struct {
+    CreatedAt *int64
+    Name      *string
+}
+type Output struct {
+    CreatedAt int64
+    Name      *string
+}
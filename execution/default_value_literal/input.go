@@ -0,0 +1,20 @@
+package execution
+
+// goverter:converter
+type Converter interface {
+    // goverter:default:value Currency "EUR"
+    // goverter:default:value Limit 100
+    // goverter:default:value Enabled true
+    Convert(source Input) Output
+}
+
+type Input struct {
+    Currency *string
+    Limit    *int
+    Enabled  *bool
+}
+type Output struct {
+    Currency string
+    Limit    int
+    Enabled  bool
+}
@@ -0,0 +1,14 @@
+package execution
+
+// goverter:converter
+type Converter interface {
+    // goverter:default:value Limit 300
+    Convert(source Input) Output
+}
+
+type Input struct {
+    Limit *uint8
+}
+type Output struct {
+    Limit *uint8
+}
@@ -0,0 +1,19 @@
+package execution
+
+// goverter:converter
+type Converter interface {
+    // goverter:map Name Title | ToTitle
+    // goverter:default:value Title "none"
+    Convert(source Input) Output
+}
+
+func ToTitle(name *string) string {
+    return *name
+}
+
+type Input struct {
+    Name *string
+}
+type Output struct {
+    Title string
+}
@@ -0,0 +1,15 @@
+package execution
+
+// goverter:converter
+type Converter interface {
+    // goverter:default:value Version 1
+    Convert(source Input) Output
+}
+
+type Input struct {
+    Name string
+}
+type Output struct {
+    Name    string
+    Version int
+}
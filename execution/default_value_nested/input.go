@@ -0,0 +1,25 @@
+package execution
+
+// goverter:converter
+type Converter interface {
+    // goverter:map Account.Settings.Currency Currency
+    // goverter:default:value Currency DefaultCurrency
+    Convert(source Input) Output
+}
+
+const DefaultCurrency = "EUR"
+
+type Currency string
+
+type Input struct {
+    Account *Account
+}
+type Account struct {
+    Settings *Settings
+}
+type Settings struct {
+    Currency Currency
+}
+type Output struct {
+    Currency Currency
+}
@@ -0,0 +1,37 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import execution "github.com/jmattheis/goverter/execution"
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source execution.Input) execution.Output {
+	var executionOutput execution.Output
+	if source.Currency != nil && *source.Currency != "" {
+		xstring := *source.Currency
+		executionOutput.Currency = &xstring
+	} else {
+		var xstring2 string = "EUR"
+		executionOutput.Currency = &xstring2
+	}
+	if source.Limit != 0 {
+		executionOutput.Limit = source.Limit
+	} else {
+		executionOutput.Limit = 100
+	}
+	if source.Tags != nil {
+		if source.Tags != nil {
+			executionOutput.Tags = make([]string, len(source.Tags))
+			for i := 0; i < len(source.Tags); i++ {
+				executionOutput.Tags[i] = source.Tags[i]
+			}
+		}
+	} else {
+		executionOutput.Tags = execution.Tags
+	}
+	executionOutput.Name = source.Name
+	return executionOutput
+}
@@ -0,0 +1,25 @@
+package execution
+
+// goverter:converter
+type Converter interface {
+    // goverter:default:value:zero
+    // goverter:default:value Currency "EUR"
+    // goverter:default:value Limit 100
+    // goverter:default:value Tags Tags
+    Convert(source Input) Output
+}
+
+var Tags = []string{"default"}
+
+type Input struct {
+    Currency *string
+    Limit    int
+    Tags     []string
+    Name     string
+}
+type Output struct {
+    Currency *string
+    Limit    int
+    Tags     []string
+    Name     string
+}
@@ -0,0 +1,21 @@
+package structs
+
+// goverter:converter
+// goverter:extend InputToOutput
+type Converter interface {
+    // goverter:context ctx
+    Convert(source Input, ctx string) Output
+}
+
+type Input struct {
+    ID int
+    Age int
+}
+type Output struct {
+    ID int
+    Age string
+}
+// goverter:context ctx
+func InputToOutput(source Input, ctx string) Output {
+    return Output{}
+}
@@ -0,0 +1,20 @@
+package structs
+
+// goverter:converter
+// goverter:extend InputToOutput
+type Converter interface {
+    Convert(source Input) Output
+}
+
+type Input struct {
+    ID int
+    Age int
+}
+type Output struct {
+    ID int
+    Age string
+}
+// goverter:context ctx
+func InputToOutput(source Input, ctx string) Output {
+    return Output{}
+}
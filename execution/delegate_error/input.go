@@ -0,0 +1,17 @@
+package structs
+
+// goverter:converter
+// goverter:extend InputToOutput
+type Converter interface {
+    Convert(source Input) (Output, error)
+}
+
+type Input struct {
+    ID int
+}
+type Output struct {
+    ID int
+}
+func InputToOutput(source Input) (Output, error) {
+    return Output{}, nil
+}
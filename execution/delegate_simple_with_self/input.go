@@ -0,0 +1,19 @@
+package structs
+
+// goverter:converter
+// goverter:extend InputToOutput
+type Converter interface {
+    Convert(source Input) Output
+}
+
+type Input struct {
+    ID int
+    Age int
+}
+type Output struct {
+    ID int
+    Age string
+}
+func InputToOutput(c Converter, source Input) Output {
+    return Output{}
+}
@@ -0,0 +1,26 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import execution "github.com/jmattheis/goverter/execution"
+
+type OneImpl struct{}
+
+var OneConvert = OneImpl{}
+
+type SimpleImpl struct{}
+
+var SimpleConvert = SimpleImpl{}
+
+func (c *SimpleImpl) Convert(source struct {
+	Value int
+}, context string) struct {
+	Value string
+} {
+	var unnamed struct {
+		Value string
+	}
+	unnamed.Value = execution.FromContext(context)
+	_ = source
+	return unnamed
+}
@@ -0,0 +1,22 @@
+package directive
+
+//goverter:converter
+type Simple interface {
+    //goverter:context c
+    //goverter:map Value | FromContext
+    Convert(source struct{ Value int }, c string) struct{ Value string }
+}
+
+type (
+    //goverter:converter
+    One interface{}
+    Two interface{}
+)
+
+//goverter:variables
+var (
+    Convert func(string) string
+)
+
+//goverter:context c
+func FromContext(c string) string { return c }
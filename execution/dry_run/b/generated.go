@@ -0,0 +1,2 @@
+// changed
+package b
@@ -0,0 +1,19 @@
+package structs
+
+// goverter:converter
+// goverter:output:file ./a/generated.go
+type A interface {
+    Convert(source string) string
+}
+
+// goverter:converter
+// goverter:output:file ./b/generated.go
+type B interface {
+    Convert(source int) int
+}
+
+// goverter:converter
+// goverter:output:file ./c/generated.go
+type C interface {
+    Convert(source bool) bool
+}
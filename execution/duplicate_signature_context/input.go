@@ -0,0 +1,15 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:context ctx
+    Convert(source Input, ctx string) Output
+    Convert2(source Input) Output
+}
+
+type Input struct {
+    ID int
+}
+type Output struct {
+    ID int
+}
@@ -0,0 +1,16 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:context ctx
+    Convert(source Input, ctx string) Output
+    // goverter:context ctx
+    Convert2(source Input, ctx int) Output
+}
+
+type Input struct {
+    ID int
+}
+type Output struct {
+    ID int
+}
@@ -0,0 +1,16 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    Convert(source Input) Output
+    Convert2(source Input) Output
+}
+
+type Input struct {
+    ID int
+    Age int
+}
+type Output struct {
+    ID int
+    Age string
+}
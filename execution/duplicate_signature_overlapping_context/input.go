@@ -0,0 +1,19 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:context ctxI
+    // goverter:context ctxS
+    Convert(source Input, ctxI int, ctxS string) Output
+    // goverter:context ctxI
+    Convert2(source Input, ctxI int) Output
+}
+
+type Input struct {
+    ID int
+    Age int
+}
+type Output struct {
+    ID int
+    Age string
+}
@@ -0,0 +1,19 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:context ctxI
+    Convert(source Input, ctxI int) Output
+    // goverter:context ctxI
+    // goverter:context ctxS
+    Convert2(source Input, ctxI int, ctxS string) Output
+}
+
+type Input struct {
+    ID int
+    Age int
+}
+type Output struct {
+    ID int
+    Age string
+}
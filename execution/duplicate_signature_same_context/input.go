@@ -0,0 +1,18 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:context ctx
+    Convert(source Input, ctx string) Output
+    // goverter:context ctx
+    Convert2(source Input, ctx string) Output
+}
+
+type Input struct {
+    ID int
+    Age int
+}
+type Output struct {
+    ID int
+    Age string
+}
@@ -0,0 +1,13 @@
+package example
+
+import (
+    input "github.com/jmattheis/goverter/execution/input"
+    output "github.com/jmattheis/goverter/execution/output"
+)
+
+// goverter:converter
+// goverter:enum:unknown @panic
+// goverter:enum no
+type Converter interface {
+    Convert(input.Color) output.Color
+}
@@ -0,0 +1,14 @@
+package example
+
+import (
+    input "github.com/jmattheis/goverter/execution/input"
+    output "github.com/jmattheis/goverter/execution/output"
+)
+
+// goverter:converter
+// goverter:enum:unknown @panic
+type Converter interface {
+    // goverter:enum:map Default @ignore
+    // goverter:enum:map Gray @panic
+    Convert(input.Color) output.Color
+}
@@ -0,0 +1,9 @@
+package input
+
+type Color int
+
+const (
+    Default = Gray
+    Green Color = 1
+    Gray  Color = 2
+)
@@ -0,0 +1,27 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	input "github.com/jmattheis/goverter/execution/input"
+	output "github.com/jmattheis/goverter/execution/output"
+)
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source input.Color) output.Color {
+	var outputColor output.Color
+	switch source {
+	case input.Default:
+		outputColor = output.Default
+	// Skipped Gray(2) -> Gray(gray) because it duplicates Default(2) -> Default(gray)
+	case input.Green:
+		outputColor = output.Green
+	default:
+		panic(fmt.Sprintf("unexpected enum element: %v", source))
+	}
+	return outputColor
+}
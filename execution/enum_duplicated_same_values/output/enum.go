@@ -0,0 +1,8 @@
+package output
+
+type Color string
+const (
+    Default = Gray
+    Green Color = "green"
+    Gray  Color = "gray"
+)
@@ -0,0 +1,9 @@
+package input
+
+type Color string
+
+const (
+    Green Color = "green"
+    Blue  Color = "blue"
+    Red   Color = "red"
+)
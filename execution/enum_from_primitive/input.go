@@ -0,0 +1,10 @@
+package example
+
+import (
+    output "github.com/jmattheis/goverter/execution/output"
+)
+
+// goverter:converter
+type Converter interface {
+    Convert(string) output.Color
+}
@@ -0,0 +1,8 @@
+package output
+
+type Color string
+const (
+    Default Color = "default"
+    Green Color   = "green"
+    Red   Color   = "red"
+)
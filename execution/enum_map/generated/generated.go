@@ -0,0 +1,26 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	input "github.com/jmattheis/goverter/execution/input"
+	output "github.com/jmattheis/goverter/execution/output"
+)
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source input.Color) output.Color {
+	var outputColor output.Color
+	switch source {
+	case input.Gray:
+		outputColor = output.Grey
+	case input.Green:
+		outputColor = output.Green
+	default:
+		panic(fmt.Sprintf("unexpected enum element: %v", source))
+	}
+	return outputColor
+}
@@ -0,0 +1,7 @@
+package input
+
+type Color int
+
+const (
+    Green Color = 1
+)
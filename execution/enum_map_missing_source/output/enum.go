@@ -0,0 +1,7 @@
+package output
+
+type Color string
+const (
+    Green Color = "green"
+    Grey   Color = "red"
+)
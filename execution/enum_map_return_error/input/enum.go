@@ -0,0 +1,8 @@
+package input
+
+type Color int
+
+const (
+    Green Color = 1
+    Gray  Color = 3
+)
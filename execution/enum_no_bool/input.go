@@ -0,0 +1,12 @@
+package example
+
+import (
+    input "github.com/jmattheis/goverter/execution/input"
+    output "github.com/jmattheis/goverter/execution/output"
+)
+
+// goverter:converter
+// goverter:enum:unknown @error
+type Converter interface {
+    Convert(input.Color) (output.Color, error)
+}
@@ -0,0 +1,7 @@
+package input
+
+type Color bool
+const (
+    Green Color = true
+    NotGreen Color = false
+)
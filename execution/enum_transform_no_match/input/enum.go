@@ -0,0 +1,5 @@
+package input
+
+type Color int
+
+const Green Color = iota
@@ -0,0 +1,4 @@
+package output
+
+type Color string
+const Blue Color = "blue"
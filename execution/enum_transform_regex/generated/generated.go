@@ -0,0 +1,42 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	input "github.com/jmattheis/goverter/execution/input"
+	output "github.com/jmattheis/goverter/execution/output"
+)
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source input.Color) output.Color {
+	var outputColor output.Color
+	switch source {
+	case input.ColorBlue:
+		outputColor = output.ColBlue
+	case input.ColorGreen:
+		outputColor = output.ColGreen
+	case input.ColorRed:
+		outputColor = output.ColRed
+	default:
+		panic(fmt.Sprintf("unexpected enum element: %v", source))
+	}
+	return outputColor
+}
+func (c *ConverterImpl) Convert2(source output.Color) input.Color {
+	var inputColor input.Color
+	switch source {
+	case output.ColBlue:
+		inputColor = input.ColorBlue
+	case output.ColGreen:
+		inputColor = input.ColorGreen
+	case output.ColRed:
+		inputColor = input.ColorRed
+	default:
+		panic(fmt.Sprintf("unexpected enum element: %v", source))
+	}
+	return inputColor
+}
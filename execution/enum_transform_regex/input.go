@@ -0,0 +1,15 @@
+package example
+
+import (
+    input "github.com/jmattheis/goverter/execution/input"
+    output "github.com/jmattheis/goverter/execution/output"
+)
+
+// goverter:converter
+// goverter:enum:unknown @panic
+type Converter interface {
+    // goverter:enum:transform regex Color(\w+) Col$1
+    Convert(input.Color) output.Color
+    // goverter:enum:transform regex Col(\w+) Color$1
+    Convert2(output.Color) input.Color
+}
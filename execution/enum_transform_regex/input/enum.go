@@ -0,0 +1,9 @@
+package input
+
+type Color int
+
+const (
+    ColorGreen Color = iota
+    ColorBlue
+    ColorRed
+)
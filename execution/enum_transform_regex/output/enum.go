@@ -0,0 +1,8 @@
+package output
+
+type Color string
+const (
+    ColGreen Color = "green"
+    ColBlue  Color = "blue"
+    ColRed   Color = "red"
+)
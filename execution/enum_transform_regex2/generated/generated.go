@@ -0,0 +1,41 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	execution "github.com/jmattheis/goverter/execution"
+)
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source execution.InputColor) execution.OutputColor {
+	var enumOutputColor execution.OutputColor
+	switch source {
+	case execution.ColBlue:
+		enumOutputColor = execution.BlueColor
+	case execution.ColGreen:
+		enumOutputColor = execution.GreenColor
+	case execution.ColRed:
+		enumOutputColor = execution.RedColor
+	default:
+		panic(fmt.Sprintf("unexpected enum element: %v", source))
+	}
+	return enumOutputColor
+}
+func (c *ConverterImpl) Convert2(source execution.OutputColor) execution.InputColor {
+	var enumInputColor execution.InputColor
+	switch source {
+	case execution.BlueColor:
+		enumInputColor = execution.ColBlue
+	case execution.GreenColor:
+		enumInputColor = execution.ColGreen
+	case execution.RedColor:
+		enumInputColor = execution.ColRed
+	default:
+		panic(fmt.Sprintf("unexpected enum element: %v", source))
+	}
+	return enumInputColor
+}
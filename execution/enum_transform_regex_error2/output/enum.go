@@ -0,0 +1,6 @@
+package output
+
+type Color string
+const (
+    ColGreen Color = "green"
+)
@@ -0,0 +1,18 @@
+package example
+
+// goverter:converter
+// goverter:extend ConvertUnderlying
+type Converter interface {
+    // goverter:useUnderlyingTypeMethods
+    Convert(SqlColor) Color
+}
+
+func ConvertUnderlying(s string) string {
+    return ""
+}
+
+type SqlColor string
+const SqlColorDefault SqlColor = "default"
+
+type Color string
+const ColorDefault Color = "default"
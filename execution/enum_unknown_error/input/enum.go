@@ -0,0 +1,9 @@
+package input
+
+type Color int
+
+const (
+    Green Color = iota
+    Blue
+    Red
+)
@@ -0,0 +1,17 @@
+package example
+
+import (
+    input "github.com/jmattheis/goverter/execution/input"
+    output "github.com/jmattheis/goverter/execution/output"
+)
+
+// goverter:converter
+// goverter:enum:unknown @error
+type Converter interface {
+    Convert([]Input) ([]Output, error)
+}
+
+type Input struct { Nested NestedInput }
+type Output struct { Nested NestedOutput }
+type NestedInput struct { Color input.Color }
+type NestedOutput struct { Color output.Color }
@@ -0,0 +1,8 @@
+package output
+
+type Color string
+const (
+    Green Color = "green"
+    Blue  Color = "blue"
+    Red   Color = "red"
+)
@@ -0,0 +1,11 @@
+package example
+
+import (
+    input "github.com/jmattheis/goverter/execution/input"
+    output "github.com/jmattheis/goverter/execution/output"
+)
+
+// goverter:converter
+type Converter interface {
+    Convert(input.Color) (output.Color, error)
+}
@@ -0,0 +1,4 @@
+package input
+
+type Color int
+const Green Color = iota
@@ -0,0 +1,25 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import (
+	input "github.com/jmattheis/goverter/execution/input"
+	output "github.com/jmattheis/goverter/execution/output"
+)
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source input.Color) output.Color {
+	var outputColor output.Color
+	switch source {
+	case input.Blue:
+		outputColor = output.Blue
+	case input.Green:
+		outputColor = output.Green
+	default:
+		outputColor = output.Unknown
+	}
+	return outputColor
+}
@@ -0,0 +1,12 @@
+package example
+
+import (
+    input "github.com/jmattheis/goverter/execution/input"
+    output "github.com/jmattheis/goverter/execution/output"
+)
+
+// goverter:converter
+type Converter interface {
+    // goverter:enum:unknown Unknown
+    Convert(input.Color) output.Color
+}
@@ -0,0 +1,8 @@
+package input
+
+type Color int
+
+const (
+    Green Color = iota
+    Blue
+)
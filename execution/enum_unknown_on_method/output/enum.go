@@ -0,0 +1,8 @@
+package output
+
+type Color string
+const (
+    Unknown Color = "unknown"
+    Green   Color = "green"
+    Blue    Color = "blue"
+)
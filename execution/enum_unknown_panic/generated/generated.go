@@ -0,0 +1,42 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	input "github.com/jmattheis/goverter/execution/input"
+	output "github.com/jmattheis/goverter/execution/output"
+)
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source input.Color) output.Color {
+	var outputColor output.Color
+	switch source {
+	case input.Blue:
+		outputColor = output.Blue
+	case input.Green:
+		outputColor = output.Green
+	case input.Red:
+		outputColor = output.Red
+	default:
+		panic(fmt.Sprintf("unexpected enum element: %v", source))
+	}
+	return outputColor
+}
+func (c *ConverterImpl) Convert2(source output.Color) input.Color {
+	var inputColor input.Color
+	switch source {
+	case output.Blue:
+		inputColor = input.Blue
+	case output.Green:
+		inputColor = input.Green
+	case output.Red:
+		inputColor = input.Red
+	default:
+		panic(fmt.Sprintf("unexpected enum element: %v", source))
+	}
+	return inputColor
+}
@@ -0,0 +1,17 @@
+package example
+
+import (
+    input "github.com/jmattheis/goverter/execution/input"
+    output "github.com/jmattheis/goverter/execution/output"
+)
+
+// goverter:converter
+// goverter:enum:unknown @panic
+type Converter interface {
+    // goverter:default DefaultColor
+    Convert(input.Color) (output.Color, error)
+}
+
+func DefaultColor() output.Color {
+    return output.Green
+}
@@ -0,0 +1,37 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import execution "github.com/jmattheis/goverter/execution"
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source []execution.Input) ([]execution.Output, error) {
+	var slicesOutputList []execution.Output
+	if source != nil {
+		slicesOutputList = make([]execution.Output, len(source))
+		for i := 0; i < len(source); i++ {
+			slicesOutput, err := c.slicesInputToSlicesOutput(source[i])
+			if err != nil {
+				return nil, err
+			}
+			slicesOutputList[i] = slicesOutput
+		}
+	}
+	return slicesOutputList, nil
+}
+func (c *ConverterImpl) ConvertX(source string) (string, error) {
+	return source, nil
+}
+func (c *ConverterImpl) slicesInputToSlicesOutput(source execution.Input) (execution.Output, error) {
+	var slicesOutput execution.Output
+	xstring, err := c.ConvertX(source.Name)
+	if err != nil {
+		return slicesOutput, err
+	}
+	slicesOutput.Name = xstring
+	slicesOutput.Age = source.Age
+	return slicesOutput, nil
+}
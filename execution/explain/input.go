@@ -0,0 +1,34 @@
+package structs
+
+// goverter:converter
+// goverter:extend ParseAge
+type Converter interface {
+    // goverter:map Name FullName
+    // goverter:map Tags Labels | JoinTags
+    // goverter:context ctx
+    Convert(source Input, ctx string) Output
+}
+
+// goverter:context ctx
+func ParseAge(age string, ctx string) int { return 0 }
+func JoinTags(tags []string) string { return "" }
+
+type Input struct {
+    Name string
+    Age string
+    Tags []string
+    Address *Address
+    Items []Item
+}
+type Address struct { Street string }
+type Item struct { ID int }
+
+type Output struct {
+    FullName string
+    Age int
+    Labels string
+    Address *OutAddress
+    Items []OutItem
+}
+type OutAddress struct { Street string }
+type OutItem struct { ID int }
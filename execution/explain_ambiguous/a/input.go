@@ -0,0 +1,6 @@
+package a
+
+// goverter:converter
+type Converter interface {
+    Convert(source string) string
+}
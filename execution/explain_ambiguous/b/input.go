@@ -0,0 +1,6 @@
+package b
+
+// goverter:converter
+type Converter interface {
+    Convert(source string) string
+}
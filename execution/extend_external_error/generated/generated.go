@@ -0,0 +1,22 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import (
+	execution "github.com/jmattheis/goverter/execution"
+	"strconv"
+)
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source execution.Input) (execution.Output, error) {
+	var structsOutput execution.Output
+	xint, err := strconv.Atoi(source.Age)
+	if err != nil {
+		return structsOutput, err
+	}
+	structsOutput.Age = xint
+	return structsOutput, nil
+}
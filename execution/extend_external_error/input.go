@@ -0,0 +1,14 @@
+package structs
+
+// goverter:converter
+// goverter:extend strconv:Atoi
+type Converter interface {
+    Convert(source Input) (Output, error)
+}
+
+type Input struct {
+    Age string
+}
+type Output struct {
+    Age int
+}
@@ -0,0 +1,8 @@
+package convert
+
+func StringToBool(s string) bool {
+    return stringToBool(s)
+}
+func stringToBool(s string) bool {
+    return true
+}
@@ -0,0 +1,18 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import (
+	execution "github.com/jmattheis/goverter/execution"
+	convert "github.com/jmattheis/goverter/execution/convert"
+)
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source execution.Input) (execution.Output, error) {
+	var structsOutput execution.Output
+	structsOutput.Done = convert.StringToBool(source.Done)
+	return structsOutput, nil
+}
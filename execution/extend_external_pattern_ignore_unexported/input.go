@@ -0,0 +1,14 @@
+package structs
+
+// goverter:converter
+// goverter:extend github.com/jmattheis/goverter/execution/convert:.*
+type Converter interface {
+    Convert(source Input) (Output, error)
+}
+
+type Input struct {
+    Done string
+}
+type Output struct {
+    Done bool
+}
@@ -0,0 +1,7 @@
+package structs
+
+// goverter:converter
+// it won't match anything because regexp requires .* instead of a regular wildcard
+// goverter:extend strconv:Parse*
+type Converter interface {
+}
@@ -0,0 +1,4 @@
+package generated
+func IntToString(int) string {
+    return ""
+}
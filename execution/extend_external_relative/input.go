@@ -0,0 +1,16 @@
+package execution
+
+// goverter:converter
+// the next line enables string converters from local package
+// goverter:output:package github.com/jmattheis/goverter/execution/generated
+// goverter:extend ./generated:IntToString
+type Converter interface {
+    Convert(source Input) Output
+}
+
+type Input struct {
+    Age   int
+}
+type Output struct {
+    Age   string
+}
@@ -0,0 +1,5 @@
+package generated
+
+func intToString(int) string {
+    return ""
+}
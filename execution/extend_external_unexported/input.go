@@ -0,0 +1,15 @@
+package execution
+
+// goverter:converter
+// goverter:output:package github.com/jmattheis/goverter/execution/generated
+// goverter:extend github.com/jmattheis/goverter/execution/generated:intToString
+type Converter interface {
+    Convert(source Input) Output
+}
+
+type Input struct {
+    Age   int
+}
+type Output struct {
+    Age   string
+}
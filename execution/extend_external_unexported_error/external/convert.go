@@ -0,0 +1,5 @@
+package external
+
+func intToString(int) string {
+    return ""
+}
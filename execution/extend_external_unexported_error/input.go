@@ -0,0 +1,15 @@
+package execution
+
+// goverter:converter
+// the next line enables string converters from local package
+// goverter:extend github.com/jmattheis/goverter/execution/external:intToString
+type Converter interface {
+    Convert(source Input) Output
+}
+
+type Input struct {
+    Age   int
+}
+type Output struct {
+    Age   string
+}
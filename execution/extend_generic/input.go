@@ -0,0 +1,13 @@
+package structs
+
+// goverter:converter
+// goverter:extend Extend
+type Converter interface {
+    Convert(source Input) (Output, error)
+}
+
+func Extend[T any](T) int {
+    return 0
+}
+type Input struct { Age string }
+type Output struct { Age int }
@@ -0,0 +1,28 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	execution "github.com/jmattheis/goverter/execution"
+)
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source execution.Input) (execution.Output, error) {
+	var structsOutput execution.Output
+	xstring, err := execution.EmptyConverter(source.Name)
+	if err != nil {
+		return structsOutput, fmt.Errorf("error setting field Name: %w", err)
+	}
+	structsOutput.Name = xstring
+	structsOutput.Age = execution.ZeroConvert(source.Age)
+	structsEnum2, err := execution.EnumConverter(source.Enum)
+	if err != nil {
+		return structsOutput, fmt.Errorf("error setting field Enum: %w", err)
+	}
+	structsOutput.Enum = structsEnum2
+	return structsOutput, nil
+}
@@ -0,0 +1,31 @@
+package structs
+
+type Enum1 int
+type Enum2 string
+
+// goverter:converter
+// goverter:extend ZeroConvert EmptyConverter EnumConverter
+type Converter interface {
+    Convert(source Input) (Output, error)
+}
+
+func ZeroConvert(*int) int {
+    return 0
+}
+func EmptyConverter(*string) (string, error) {
+    return "", nil
+}
+func EnumConverter(Enum1) (Enum2, error) {
+    return "", nil
+}
+
+type Input struct {
+    Name *string
+    Age *int
+    Enum Enum1
+}
+type Output struct {
+    Name string
+    Age int
+    Enum Enum2
+}
@@ -0,0 +1,23 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	execution "github.com/jmattheis/goverter/execution"
+	"strconv"
+)
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source execution.Input) (execution.Output, error) {
+	var structsOutput execution.Output
+	xint, err := strconv.Atoi(source.PostalCode)
+	if err != nil {
+		return structsOutput, fmt.Errorf("error setting field PostalCode: %w", err)
+	}
+	structsOutput.PostalCode = xint
+	return structsOutput, nil
+}
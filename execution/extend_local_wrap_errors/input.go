@@ -0,0 +1,18 @@
+package structs
+
+type Enum1 int
+type Enum2 string
+
+// goverter:converter
+// goverter:extend strconv:Atoi
+// goverter:wrapErrors
+type Converter interface {
+    Convert(source Input) (Output, error)
+}
+
+type Input struct {
+    PostalCode string
+}
+type Output struct {
+    PostalCode int
+}
@@ -0,0 +1,29 @@
+package structs
+
+// goverter:converter
+// goverter:extend StringPToString
+type Converter interface {
+    // goverter:map Address.Civic Civic
+    ConvertPerson(source Person) APIPerson
+}
+
+func StringPToString(value *string) string {
+    if value == nil {
+        return ""
+    }
+    return *value
+}
+
+type Person struct {
+    Name    string
+    Address *Address
+}
+
+type Address struct {
+    Civic  string
+}
+
+type APIPerson struct {
+    Name   string
+    Civic  string
+}
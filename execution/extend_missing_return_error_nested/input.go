@@ -0,0 +1,29 @@
+package slices
+
+// goverter:converter
+// goverter:extend ZeroConvert EmptyConverter
+type Converter interface {
+    Convert(source []Input) []Output
+}
+
+func ZeroConvert(*int) (int, error) {
+    return 0, nil
+}
+func EmptyConverter(Converter, *string) string {
+    return ""
+}
+
+type Input struct {
+    Nested InputNested
+}
+type Output struct {
+    Nested OutputNested
+}
+type InputNested struct {
+    Name *string
+    Age *int
+}
+type OutputNested struct {
+    Name string
+    Age int
+}
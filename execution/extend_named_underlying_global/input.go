@@ -0,0 +1,18 @@
+package structs
+
+// goverter:converter
+// goverter:extend ConvertUnderlying
+// goverter:useUnderlyingTypeMethods
+type Converter interface {
+    Convert(source Input) Output
+}
+
+func ConvertUnderlying(s int) string {
+    return ""
+}
+
+type InputID  int
+type OutputID string
+
+type Input struct  { ID InputID  }
+type Output struct { ID OutputID }
@@ -0,0 +1,18 @@
+package structs
+
+// goverter:converter
+// goverter:extend ConvertUnderlying
+type Converter interface {
+    // goverter:useUnderlyingTypeMethods
+    Convert(source Input) Output
+}
+
+func ConvertUnderlying(s InputID) string {
+    return ""
+}
+
+type InputID  int
+type OutputID string
+
+type Input struct  { ID InputID  }
+type Output struct { ID OutputID }
@@ -0,0 +1,6 @@
+package structs
+
+// goverter:converter
+// goverter:extend file=/nonexisting:Convert
+type Converter interface {
+}
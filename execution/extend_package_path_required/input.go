@@ -0,0 +1,6 @@
+package structs
+
+// goverter:converter
+// goverter:extend :Convert
+type Converter interface {
+}
@@ -0,0 +1,5 @@
+package convert
+
+func stringToBool(s string) bool {
+    return true
+}
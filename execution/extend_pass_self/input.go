@@ -0,0 +1,18 @@
+package structs
+
+// goverter:converter
+// goverter:extend EmptyConverter
+type Converter interface {
+    Convert(source Input) Output
+}
+
+func EmptyConverter(Converter, *string) string {
+    return ""
+}
+
+type Input struct {
+    Name *string
+}
+type Output struct {
+    Name string
+}
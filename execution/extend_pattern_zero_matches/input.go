@@ -0,0 +1,6 @@
+package structs
+
+// goverter:converter
+// goverter:extend HelloWorld.*
+type Converter interface {
+}
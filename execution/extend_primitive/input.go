@@ -0,0 +1,11 @@
+package slices
+
+// goverter:converter
+// goverter:extend Conv
+type Converter interface {
+    Convert(source string) (*int, error)
+}
+
+func Conv(string) (int, error) {
+    return 0, nil
+}
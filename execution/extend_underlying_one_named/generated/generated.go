@@ -0,0 +1,45 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import execution "github.com/jmattheis/goverter/execution"
+
+type C1Impl struct{}
+
+var C1Convert = C1Impl{}
+
+func (c *C1Impl) C(source execution.X[execution.IntID]) execution.X[execution.StringID] {
+	var underlyingX execution.X[execution.StringID]
+	underlyingX.T = execution.StringID(execution.IntToString(int(source.T)))
+	return underlyingX
+}
+
+type C2Impl struct{}
+
+var C2Convert = C2Impl{}
+
+func (c *C2Impl) C(source execution.X[execution.IntID]) execution.X[string] {
+	var underlyingX execution.X[string]
+	underlyingX.T = execution.IntToString(int(source.T))
+	return underlyingX
+}
+
+type C3Impl struct{}
+
+var C3Convert = C3Impl{}
+
+func (c *C3Impl) C(source execution.X[int]) execution.X[string] {
+	var underlyingX execution.X[string]
+	underlyingX.T = execution.IntToString(source.T)
+	return underlyingX
+}
+
+type C4Impl struct{}
+
+var C4Convert = C4Impl{}
+
+func (c *C4Impl) C(source execution.X[int]) execution.X[execution.StringID] {
+	var underlyingX execution.X[execution.StringID]
+	underlyingX.T = execution.StringID(execution.IntToString(source.T))
+	return underlyingX
+}
@@ -0,0 +1,28 @@
+package underlying
+
+// goverter:converter
+// goverter:extend IntToString
+// goverter:useUnderlyingTypeMethods
+type C1 interface { C(source X[IntID]) X[StringID] }
+
+// goverter:converter
+// goverter:extend IntToString
+// goverter:useUnderlyingTypeMethods
+type C2 interface { C(source X[IntID]) X[string] }
+
+// goverter:converter
+// goverter:extend IntToString
+// goverter:useUnderlyingTypeMethods
+type C3 interface { C(source X[int]) X[string] }
+
+// goverter:converter
+// goverter:extend IntToString
+// goverter:useUnderlyingTypeMethods
+type C4 interface { C(source X[int]) X[StringID] }
+
+func IntToString(s int) string { return "" }
+
+type IntID int
+type StringID string
+
+type X[T any] struct { T T }
@@ -0,0 +1,9 @@
+package structs
+
+// goverter:converter
+// goverter:extend HelloWorld
+type Converter interface {
+}
+func HelloWorld() int {
+    return 0
+}
@@ -0,0 +1,8 @@
+package structs
+
+// goverter:converter
+// goverter:extend HelloWorld
+type Converter interface {
+}
+func HelloWorld(int) {
+}
@@ -0,0 +1,7 @@
+package structs
+
+// goverter:converter
+// goverter:extend HelloWorld
+type Converter interface {
+}
+const HelloWorld = 5
@@ -0,0 +1,28 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // Convert converts the input.
+    // goverter:map Nmae Name
+    // goverter:ignore Extra
+    Convert(source Input) Output
+    // goverter:ignore Output
+    // goverter:map Nmae Nme
+    ConvertNested(source Input) Nested
+}
+
+type Input struct {
+    Nmae string
+    ID   int
+}
+
+type Output struct {
+    Name  string
+    ID    int
+    Extra string
+}
+
+type Nested struct {
+    Output Output
+    Nme    string
+}
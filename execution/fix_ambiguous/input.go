@@ -0,0 +1,15 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    Convert(source Input) Output
+}
+
+type Input struct {
+    Nam1 string
+    Nam2 string
+}
+
+type Output struct {
+    Nam string
+}
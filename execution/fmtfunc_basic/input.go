@@ -0,0 +1,65 @@
+package fmtfunc
+
+import "database/sql"
+
+// goverter:converter
+// goverter:output:format function
+// goverter:extend SQLStringToPString
+type Converter interface {
+    // goverter:map Owner.Name OwnerName
+    ConvertApartment(source DBApartment) APIApartment
+    // goverter:map Name FirstName
+    // goverter:ignore Age
+    ConvertPerson(source DBPerson) APIPerson
+}
+
+func SQLStringToPString(value sql.NullString) *string {
+    if value.Valid {
+        return &value.String
+    }
+    return nil
+}
+
+type DBHouse struct {
+    Address    string
+    Apartments []DBApartment
+}
+
+type DBApartment struct {
+    Position   uint
+    Owner      DBPerson
+    CoResident []DBPerson
+}
+
+type DBPerson struct {
+    ID         int
+    Name       string
+    MiddleName sql.NullString
+    Friends    []DBPerson
+    Info       Info
+}
+
+type APIHouse struct {
+    Address    string
+    Apartments map[uint]APIApartment
+}
+
+type APIApartment struct {
+    Position   uint
+    Owner      APIPerson
+    OwnerName  string
+    CoResident []APIPerson
+}
+
+type APIPerson struct {
+    ID         int
+    MiddleName *string
+    FirstName  *string
+    Friends    []APIPerson
+    Info       Info
+    Age        int
+}
+
+type Info struct {
+    Birthplace string
+}
@@ -0,0 +1,20 @@
+package fmtfunc
+
+// goverter:converter
+// goverter:output:format function
+// goverter:extend Custom
+type Converter interface {
+    ConvertPerson(source Input) Output
+}
+
+func Custom(c Converter, s string) uint {
+    return 1
+}
+
+type Input struct {
+    Value    string
+}
+
+type Output struct {
+    Value   uint
+}
@@ -0,0 +1,21 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import execution "github.com/jmattheis/goverter/execution"
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source execution.InputWrapper[execution.Input]) execution.OutputWrapper[execution.Output] {
+	var executionOutputWrapper execution.OutputWrapper[execution.Output]
+	executionOutputWrapper.Value = c.executionInputToExecutionOutput(source.Value)
+	return executionOutputWrapper
+}
+func (c *ConverterImpl) executionInputToExecutionOutput(source execution.Input) execution.Output {
+	var executionOutput execution.Output
+	executionOutput.Name = source.Name
+	executionOutput.Age = source.Age
+	return executionOutput
+}
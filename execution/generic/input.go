@@ -0,0 +1,22 @@
+package execution
+
+// goverter:converter
+type Converter interface {
+    Convert(source InputWrapper[Input]) OutputWrapper[Output]
+}
+
+type InputWrapper[S any] struct {
+    Value S
+}
+type OutputWrapper[S any] struct {
+    Value S
+}
+
+type Input struct {
+    Name string
+    Age int
+}
+type Output struct {
+    Name string
+    Age int
+}
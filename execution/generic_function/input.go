@@ -0,0 +1,29 @@
+package structs
+
+// goverter:converter
+// goverter:output:format function
+type Converter[T, U any] interface {
+    // goverter:context mapItem
+    MapPage(source Page[T], mapItem func(T) U) PageOut[U]
+    CopyPage(source Page[T]) PageOut[T]
+}
+
+type Page[T any] struct {
+    Items []T
+    Meta  Meta[T]
+}
+
+type PageOut[T any] struct {
+    Items []T
+    Meta  MetaOut[T]
+}
+
+type Meta[T any] struct {
+    First *T
+    Total int
+}
+
+type MetaOut[T any] struct {
+    First *T
+    Total int
+}
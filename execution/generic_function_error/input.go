@@ -0,0 +1,8 @@
+package structs
+
+// goverter:converter
+// goverter:output:format function
+type Converter[K comparable, T any, U ~int | ~string] interface {
+    // goverter:context parse
+    Convert(source map[K]T, parse func(T) (U, error)) (map[K]U, error)
+}
@@ -0,0 +1,11 @@
+package structs
+
+// goverter:converter
+// goverter:output:format function
+type Converter[T, U any] interface {
+    Convert(source Page[T]) Page[U]
+}
+
+type Page[T any] struct {
+    Items []T
+}
@@ -0,0 +1,22 @@
+package execution
+
+// goverter:converter
+type Converter interface {
+    Convert(source InputWrapper[Input, *string, *struct{Name string}]) OutputWrapper[Output, *string, *struct{Name string}]
+}
+
+type InputWrapper[S, X, Y any] struct {
+    Value S
+}
+type OutputWrapper[S, X, Y any] struct {
+    Value S
+}
+
+type Input struct {
+    Name string
+    Age int
+}
+type Output struct {
+    Name string
+    Age int
+}
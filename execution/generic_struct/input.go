@@ -0,0 +1,26 @@
+package structs
+
+// goverter:converter
+// goverter:output:assert
+// goverter:output:constructor
+type Converter[K comparable, T any] interface {
+    Convert(source Page[K, T]) PageOut[K, T]
+    // goverter:context convert
+    ConvertItems(source []Item[T], convert func(T) (string, error)) ([]ItemOut, error)
+}
+
+type Page[K comparable, T any] struct {
+    Items map[K]Item[T]
+}
+
+type PageOut[K comparable, T any] struct {
+    Items map[K]Item[T]
+}
+
+type Item[T any] struct {
+    Value T
+}
+
+type ItemOut struct {
+    Value string
+}
@@ -0,0 +1,14 @@
+package structs
+
+// goverter:converter
+type Converter[T any] interface {
+    Convert(source Input[T]) Output
+}
+
+type Input[T any] struct {
+    ID T
+}
+
+type Output struct {
+    ID string
+}
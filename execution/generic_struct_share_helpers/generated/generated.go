@@ -0,0 +1,23 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import execution "github.com/jmattheis/goverter/execution"
+
+type ConverterImpl[T any] struct{}
+
+func (c *ConverterImpl[T]) Convert(source []execution.Item[T]) []execution.ItemOut[T] {
+	var structsItemOutList []execution.ItemOut[T]
+	if source != nil {
+		structsItemOutList = make([]execution.ItemOut[T], len(source))
+		for i := 0; i < len(source); i++ {
+			structsItemOutList[i] = c.structsItemToStructsItemOut(source[i])
+		}
+	}
+	return structsItemOutList
+}
+func (c *ConverterImpl[T]) structsItemToStructsItemOut(source execution.Item[T]) execution.ItemOut[T] {
+	var structsItemOut execution.ItemOut[T]
+	structsItemOut.Value = source.Value
+	return structsItemOut
+}
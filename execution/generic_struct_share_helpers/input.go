@@ -0,0 +1,15 @@
+package structs
+
+// goverter:converter
+// goverter:output:shareHelpers
+type Converter[T any] interface {
+    Convert(source []Item[T]) []ItemOut[T]
+}
+
+type Item[T any] struct {
+    Value T
+}
+
+type ItemOut[T any] struct {
+    Value T
+}
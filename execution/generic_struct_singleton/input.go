@@ -0,0 +1,11 @@
+package structs
+
+// goverter:converter
+// goverter:output:singleton
+type Converter[T any] interface {
+    Convert(source Page[T]) Page[T]
+}
+
+type Page[T any] struct {
+    Items []T
+}
@@ -0,0 +1,4 @@
+package structs
+
+// goverter:converter
+type Converter interface {}
@@ -0,0 +1,13 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    Convert(source Input) (Output, error)
+}
+
+type Input struct {
+    Done string
+}
+type Output struct {
+    Done bool
+}
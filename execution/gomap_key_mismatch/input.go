@@ -0,0 +1,6 @@
+package err
+
+// goverter:converter
+type Converter interface {
+    Convert(source map[string]int) map[int]int
+}
@@ -0,0 +1,18 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source map[string]int) map[string]int {
+	var mapStringInt map[string]int
+	if source != nil {
+		mapStringInt = make(map[string]int, len(source))
+		for key, value := range source {
+			mapStringInt[key] = value
+		}
+	}
+	return mapStringInt
+}
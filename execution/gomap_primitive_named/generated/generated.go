@@ -0,0 +1,50 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import execution "github.com/jmattheis/goverter/execution"
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source map[string]execution.ID) map[string]string {
+	var mapStringString map[string]string
+	if source != nil {
+		mapStringString = make(map[string]string, len(source))
+		for key, value := range source {
+			mapStringString[key] = string(value)
+		}
+	}
+	return mapStringString
+}
+func (c *ConverterImpl) Convert2(source map[execution.ID]string) map[string]string {
+	var mapStringString map[string]string
+	if source != nil {
+		mapStringString = make(map[string]string, len(source))
+		for key, value := range source {
+			mapStringString[string(key)] = value
+		}
+	}
+	return mapStringString
+}
+func (c *ConverterImpl) Convert3(source map[string]string) map[execution.ID]string {
+	var mapSlicesIDString map[execution.ID]string
+	if source != nil {
+		mapSlicesIDString = make(map[execution.ID]string, len(source))
+		for key, value := range source {
+			mapSlicesIDString[execution.ID(key)] = value
+		}
+	}
+	return mapSlicesIDString
+}
+func (c *ConverterImpl) Convert4(source map[string]string) map[execution.ID]execution.ID {
+	var mapSlicesIDSlicesID map[execution.ID]execution.ID
+	if source != nil {
+		mapSlicesIDSlicesID = make(map[execution.ID]execution.ID, len(source))
+		for key, value := range source {
+			mapSlicesIDSlicesID[execution.ID(key)] = execution.ID(value)
+		}
+	}
+	return mapSlicesIDSlicesID
+}
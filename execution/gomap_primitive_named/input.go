@@ -0,0 +1,10 @@
+package slices
+
+// goverter:converter
+type Converter interface {
+    Convert(source map[string]ID) map[string]string
+    Convert2(source map[ID]string) map[string]string
+    Convert3(source map[string]string) map[ID]string
+    Convert4(source map[string]string) map[ID]ID
+}
+type ID string
@@ -0,0 +1,7 @@
+package slices
+
+// goverter:converter
+type Converter interface {
+    Convert(source map[string]int) map[string]*int
+    Convert2(source map[string]*int) map[string]*int
+}
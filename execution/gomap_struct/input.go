@@ -0,0 +1,16 @@
+package slices
+
+// goverter:converter
+type Converter interface {
+    Convert(source map[string]Input) map[string]Output
+    Convert2(source map[Output]Input) map[Input]Output
+}
+
+type Input struct {
+    Name string
+    Age int
+}
+type Output struct {
+    Name string
+    Age int
+}
@@ -0,0 +1,6 @@
+package err
+
+// goverter:converter
+type Converter interface {
+    Convert(source map[int]int) map[int]int64
+}
@@ -0,0 +1,24 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import "strconv"
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source map[string]string) (map[string]int, error) {
+	var mapStringInt map[string]int
+	if source != nil {
+		mapStringInt = make(map[string]int, len(source))
+		for key, value := range source {
+			xint, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, err
+			}
+			mapStringInt[key] = xint
+		}
+	}
+	return mapStringInt, nil
+}
@@ -0,0 +1,11 @@
+package structs
+
+type Enum1 int
+type Enum2 string
+
+// goverter:converter
+// goverter:extend strconv:Atoi
+// goverter:wrapErrors
+type Converter interface {
+    Convert(map[string]string) (map[string]int, error)
+}
@@ -0,0 +1,21 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:ignoreMissing
+    // goverter:map Age | DefaultAge
+    Convert(source Input) Output
+}
+
+func DefaultAge(s string) int {
+    return 42
+}
+
+type Input struct {
+    Name string
+}
+type Output struct {
+    Name   string
+    Age    int
+    Street string
+}
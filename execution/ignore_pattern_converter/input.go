@@ -0,0 +1,24 @@
+package execution
+
+// goverter:converter
+// goverter:ignore:pattern /^XXX_/
+type Converter interface {
+    Convert(source Input) Output
+}
+
+type Input struct {
+    Name   string
+    Nested InputNested
+}
+type InputNested struct {
+    Age int
+}
+type Output struct {
+    Name          string
+    Nested        OutputNested
+    XXX_sizecache int32
+}
+type OutputNested struct {
+    Age           int
+    XXX_sizecache int32
+}
@@ -0,0 +1,14 @@
+package execution
+
+// goverter:converter
+// goverter:ignore:pattern /^XXX_/
+type Converter interface {
+    Convert(source Input) Output
+}
+
+type Input struct {
+    Name string
+}
+type Output struct {
+    Name string
+}
@@ -0,0 +1,17 @@
+package execution
+
+// goverter:converter
+// goverter:ignore:pattern Deprecated*
+type Converter interface {
+    // goverter:map Name DeprecatedName
+    Convert(source Input) Output
+}
+
+type Input struct {
+    Name string
+}
+type Output struct {
+    Name           string
+    DeprecatedName string
+    DeprecatedAge  int
+}
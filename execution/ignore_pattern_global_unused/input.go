@@ -0,0 +1,13 @@
+package execution
+
+// goverter:converter
+type Converter interface {
+    Convert(source Input) Output
+}
+
+type Input struct {
+    Name string
+}
+type Output struct {
+    Name string
+}
@@ -0,0 +1,18 @@
+package execution
+
+// goverter:converter
+type Converter interface {
+    Convert(source Input) Output
+    // goverter:ignore:pattern /^XXX_/
+    ConvertOther(source Input) Other
+}
+
+type Input struct {
+    Name string
+}
+type Output struct {
+    Name string
+}
+type Other struct {
+    Name string
+}
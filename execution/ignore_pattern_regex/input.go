@@ -0,0 +1,18 @@
+package execution
+
+// goverter:converter
+type Converter interface {
+    // goverter:ignore /^XXX_/ Deprecated*
+    Convert(source Input) Output
+}
+
+type Input struct {
+    Name string
+}
+type Output struct {
+    Name                 string
+    XXX_unrecognized     []byte
+    XXX_sizecache        int32
+    DeprecatedName       string
+    DeprecatedAge        int
+}
@@ -0,0 +1,15 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:ignoreUnexported
+    Convert(source Input) Output
+}
+
+type Input struct {
+    Name string
+}
+type Output struct {
+    Name string
+    internalState string
+}
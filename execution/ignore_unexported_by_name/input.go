@@ -0,0 +1,15 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:ignore name
+    Convert(source Input) Output
+}
+
+type Input struct {
+    Age int
+}
+type Output struct {
+    name string
+    Age int
+}
@@ -0,0 +1,14 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    Convert(source Input) Output
+}
+
+type Input struct {
+    Name string
+}
+type Output struct {
+    Name string
+    internalState string
+}
@@ -0,0 +1,21 @@
+package structs
+
+// goverter:converter
+// goverter:ignoreUnexported
+type Converter interface {
+    Convert(source Input) Output
+}
+
+type Input struct {
+    Nested NestedInput
+}
+type Output struct {
+    Nested NestedOutput
+}
+type NestedInput struct {
+    Name string
+}
+type NestedOutput struct {
+    Name string
+    internalState string
+}
@@ -0,0 +1,9 @@
+package api
+
+type User struct {
+    ID       int
+    Fullname string
+    Email    string
+    Created  string
+    internal bool
+}
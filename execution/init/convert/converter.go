@@ -0,0 +1,15 @@
+package convert
+
+import (
+	api "github.com/jmattheis/goverter/execution/api"
+	db "github.com/jmattheis/goverter/execution/db"
+)
+
+// goverter:converter
+type Converter interface {
+	// goverter:map FullName Fullname
+	// goverter:map Mail Email
+	// goverter:ignore Created
+	// goverter:ignore internal
+	Convert(source db.User) api.User
+}
@@ -0,0 +1,20 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import (
+	api "github.com/jmattheis/goverter/execution/api"
+	db "github.com/jmattheis/goverter/execution/db"
+)
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source db.User) api.User {
+	var apiUser api.User
+	apiUser.ID = source.ID
+	apiUser.Fullname = source.FullName
+	apiUser.Email = source.Mail
+	return apiUser
+}
@@ -0,0 +1,8 @@
+package db
+
+type User struct {
+    ID        int
+    FullName  string
+    Mail      string
+    CreatedAt int64
+}
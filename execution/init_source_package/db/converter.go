@@ -0,0 +1,7 @@
+package db
+
+// goverter:converter
+type Converter interface {
+	// goverter:map Name Named
+	Convert(source User) UserDTO
+}
@@ -0,0 +1,10 @@
+package db
+
+type User struct {
+    ID   int
+    Name string
+}
+type UserDTO struct {
+    ID    int
+    Named string
+}
@@ -0,0 +1,35 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import (
+	execution "github.com/jmattheis/goverter/execution"
+	"io"
+)
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source map[string]interface {
+	io.Reader
+	Test() bool
+}) map[string]interface {
+	io.Writer
+	Test() bool
+} {
+	var mapStringUnknown map[string]interface {
+		io.Writer
+		Test() bool
+	}
+	if source != nil {
+		mapStringUnknown = make(map[string]interface {
+			io.Writer
+			Test() bool
+		}, len(source))
+		for key, value := range source {
+			mapStringUnknown[key] = execution.ConvertInterface(value)
+		}
+	}
+	return mapStringUnknown
+}
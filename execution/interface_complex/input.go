@@ -0,0 +1,25 @@
+package structs
+
+import "io"
+
+// goverter:converter
+// goverter:extend ConvertInterface
+type Converter interface {
+    Convert(source map[string]interface {
+        io.Reader
+        Test() bool
+    }) map[string]interface {
+        io.Writer
+        Test() bool
+    }
+}
+
+func ConvertInterface(x interface {
+    io.Reader
+    Test() bool
+}) interface {
+    io.Writer
+    Test() bool
+} {
+    return nil
+}
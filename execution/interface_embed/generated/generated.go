@@ -0,0 +1,31 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import (
+	execution "github.com/jmattheis/goverter/execution"
+	"io"
+)
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source map[string]interface {
+	io.Reader
+}) map[string]interface {
+	io.Writer
+} {
+	var mapStringUnknown map[string]interface {
+		io.Writer
+	}
+	if source != nil {
+		mapStringUnknown = make(map[string]interface {
+			io.Writer
+		}, len(source))
+		for key, value := range source {
+			mapStringUnknown[key] = execution.ConvertInterface(value)
+		}
+	}
+	return mapStringUnknown
+}
@@ -0,0 +1,13 @@
+package structs
+
+import "io"
+
+// goverter:converter
+// goverter:extend ConvertInterface
+type Converter interface {
+    Convert(source map[string]interface{ io.Reader }) map[string]interface{ io.Writer }
+}
+
+func ConvertInterface(x interface{io.Reader}) interface{io.Writer} {
+    return nil
+}
@@ -0,0 +1,11 @@
+package structs
+
+// goverter:converter
+// goverter:extend ConvertInterface
+type Converter interface {
+    Convert(source map[string]interface{}) map[string]interface{}
+}
+
+func ConvertInterface(x interface{}) interface{} {
+    return nil
+}
@@ -0,0 +1,6 @@
+package fmtfunc
+
+// goverter:converter
+type Converter interface {
+    ConvertPerson(c Converter, source int) int
+}
@@ -0,0 +1,28 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import execution "github.com/jmattheis/goverter/execution"
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source map[string]interface {
+	Test(bool) (string, error)
+}) map[string]interface {
+	Test2()
+} {
+	var mapStringUnknown map[string]interface {
+		Test2()
+	}
+	if source != nil {
+		mapStringUnknown = make(map[string]interface {
+			Test2()
+		}, len(source))
+		for key, value := range source {
+			mapStringUnknown[key] = execution.ConvertInterface(value)
+		}
+	}
+	return mapStringUnknown
+}
@@ -0,0 +1,15 @@
+package structs
+
+// goverter:converter
+// goverter:extend ConvertInterface
+type Converter interface {
+    Convert(source map[string]interface {
+        Test(x bool) (l string, err error)
+    }) map[string]interface{ Test2() }
+}
+
+func ConvertInterface(x interface {
+    Test(x bool) (l string, err error)
+}) interface{ Test2() } {
+    return nil
+}
@@ -0,0 +1,3 @@
+package output
+
+type X string
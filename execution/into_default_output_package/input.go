@@ -0,0 +1,9 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    ConvertPerson(source Input) Output
+}
+
+type Input struct { Name string }
+type Output struct { Name string }
@@ -0,0 +1,11 @@
+package structs
+
+// goverter:converter
+// goverter:output:file @cwd/conv/generated.go
+// goverter:extend github.com/jmattheis/goverter/execution/conv:StringPToString
+type Converter interface {
+    ConvertPerson(source Input) Output
+}
+
+type Input struct { Name string }
+type Output struct { Name string }
@@ -0,0 +1,8 @@
+package output
+
+func StringPToString(value *string) string {
+    if value == nil {
+        return ""
+    }
+    return *value
+}
@@ -0,0 +1,12 @@
+package structs
+
+// goverter:converter
+// goverter:output:file ./conv/generated.go
+// goverter:output:package :output
+// goverter:extend github.com/jmattheis/goverter/execution/conv:StringPToString
+type Converter interface {
+    ConvertPerson(source Input) Output
+}
+
+type Input struct { Name *string }
+type Output struct { Name string }
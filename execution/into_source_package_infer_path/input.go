@@ -0,0 +1,19 @@
+package structs
+
+// goverter:converter
+// goverter:extend StringPToString
+// goverter:output:file ./generated.go
+// goverter:output:package :structs
+type Converter interface {
+    ConvertPerson(source Input) Output
+}
+
+func StringPToString(value *string) string {
+    if value == nil {
+        return ""
+    }
+    return *value
+}
+
+type Input struct { Name    *string }
+type Output struct { Name   string }
@@ -0,0 +1,4 @@
+package err
+
+// goverter:converter
+const x = 5
@@ -0,0 +1,11 @@
+package structs
+
+// goverter:converter
+type (
+    Converter interface {
+
+    }
+    Converter2 interface {
+
+    }
+)
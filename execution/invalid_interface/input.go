@@ -0,0 +1,4 @@
+package err
+
+// goverter:converter
+type Converter struct {}
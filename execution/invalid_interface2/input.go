@@ -0,0 +1,6 @@
+package err
+
+type (
+    // goverter:converter
+    Converter struct {}
+)
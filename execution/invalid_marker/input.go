@@ -0,0 +1,9 @@
+package example
+
+// goverter:variables
+type Converter interface {
+    ConvertPerson(source Input) Output
+}
+
+type Input struct { Name    string }
+type Output struct { Name   string }
@@ -0,0 +1,6 @@
+package structs
+
+// goverter:converter
+// goverter:not_existing
+type Converter interface {
+}
@@ -0,0 +1,7 @@
+package structs
+
+type (
+    // goverter:converter
+    // goverter:
+    Converter interface { }
+)
@@ -0,0 +1,7 @@
+package err
+
+// goverter:converter
+type Converter interface {
+    // goverter:oops
+    ConvertString(source string) string
+}
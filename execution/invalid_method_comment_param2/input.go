@@ -0,0 +1,9 @@
+package err
+
+type (
+    // goverter:converter
+    Converter interface {
+        // goverter:oops
+        ConvertString(source string) string
+    }
+)
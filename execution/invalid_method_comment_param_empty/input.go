@@ -0,0 +1,9 @@
+package err
+
+type (
+    // goverter:converter
+    Converter interface {
+        // goverter:
+        ConvertString(source string) string
+    }
+)
@@ -0,0 +1,13 @@
+package execution
+
+// goverter:converter
+// goverter:output:format aabcd
+type Converter interface {
+    Convert(Input) Output
+}
+type Input struct {
+    Name       string
+}
+type Output struct {
+    Name       string
+}
@@ -0,0 +1,6 @@
+package err
+
+// goverter:converter
+type Converter interface {
+    Convert(map[string]int)
+}
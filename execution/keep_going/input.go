@@ -0,0 +1,28 @@
+package execution
+
+// goverter:converter
+type Converter interface {
+    // goverter:map Missing Name
+    Convert(source Input) Output
+    // goverter:map Unknown Name
+    ConvertOther(source Other) Output
+}
+
+// goverter:converter
+// goverter:output:file ./other/generated.go
+type ParseConverter interface {
+    // goverter:unknownSetting
+    Convert(source Input) Output
+    // goverter:ignoreX
+    ConvertOther(source Other) Output
+}
+
+// goverter:converter
+// goverter:output:file ./valid/generated.go
+type ValidConverter interface {
+    Convert(source Input) Output
+}
+
+type Input struct{ Name string }
+type Other struct{ Name string }
+type Output struct{ Name string }
@@ -0,0 +1,13 @@
+package execution
+
+// goverter:converter
+type Converter interface {
+    // goverter:map Missing Name
+    Convert(source Input) Output
+    // goverter:map Unknown Name
+    ConvertOther(source Other) Output
+}
+
+type Input struct{ Name string }
+type Other struct{ Name string }
+type Output struct{ Name string }
@@ -0,0 +1,30 @@
+package structs
+
+// goverter:converter
+// goverter:output:file ./conv/generated.go
+type Converter interface {
+    // goverter:map Name FullName
+    Convert(source Input) Output
+    // goverter:update target
+    // goverter:map Name FullName
+    Update(source Input, target *Output) error
+}
+
+// goverter:converter
+// goverter:output:format function
+// goverter:output:package :mapping
+type Functions interface {
+    ConvertItems(source []Item) []OutItem
+}
+
+type Input struct {
+    Name string
+    Items []Item
+}
+type Item struct { ID int }
+
+type Output struct {
+    FullName string
+    Items []OutItem
+}
+type OutItem struct { ID int }
@@ -0,0 +1,59 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import (
+	execution "github.com/jmattheis/goverter/execution"
+	"runtime"
+	"sync"
+)
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source []execution.Input) []execution.Output {
+	var structsOutputList []execution.Output
+	if source != nil {
+		structsOutputList = make([]execution.Output, len(source))
+		if len(source) >= 1000 {
+			workers := runtime.GOMAXPROCS(0)
+			chunk := (len(source) + workers - 1) / workers
+			panics := make([]any, workers)
+			var wg sync.WaitGroup
+			for worker := 0; worker*chunk < len(source); worker++ {
+				start := worker * chunk
+				end := start + chunk
+				if end > len(source) {
+					end = len(source)
+				}
+				wg.Add(1)
+				go func(worker, start, end int) {
+					defer wg.Done()
+					defer func() {
+						panics[worker] = recover()
+					}()
+					for i := start; i < end; i++ {
+						structsOutputList[i] = c.structsInputToStructsOutput(source[i])
+					}
+				}(worker, start, end)
+			}
+			wg.Wait()
+			for _, p := range panics {
+				if p != nil {
+					panic(p)
+				}
+			}
+		} else {
+			for i := 0; i < len(source); i++ {
+				structsOutputList[i] = c.structsInputToStructsOutput(source[i])
+			}
+		}
+	}
+	return structsOutputList
+}
+func (c *ConverterImpl) structsInputToStructsOutput(source execution.Input) execution.Output {
+	var structsOutput execution.Output
+	structsOutput.Name = source.Name
+	return structsOutput
+}
@@ -0,0 +1,24 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import (
+	execution "github.com/jmattheis/goverter/execution"
+	"strconv"
+)
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source execution.Input) (execution.Output, error) {
+	var structsOutput execution.Output
+	for i := 0; i < len(source.Items); i++ {
+		xint, err := strconv.Atoi(source.Items[i])
+		if err != nil {
+			return structsOutput, err
+		}
+		structsOutput.Items[i] = xint
+	}
+	return structsOutput, nil
+}
@@ -0,0 +1,20 @@
+package structs
+
+import "strconv"
+
+// goverter:converter
+// goverter:extend strconv:Atoi
+// goverter:list:parallel 2
+type Converter interface {
+    Convert(source Input) (Output, error)
+}
+
+type Input struct {
+    Items [64]string
+}
+
+type Output struct {
+    Items [64]int
+}
+
+var _ = strconv.Atoi
@@ -0,0 +1,80 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import (
+	execution "github.com/jmattheis/goverter/execution"
+	"runtime"
+	"sync"
+)
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) ConvertStruct(source execution.InputList) (execution.OutputList, error) {
+	var structsOutputList execution.OutputList
+	if source.Items != nil {
+		structsOutputList.Items = make([]execution.Output, len(source.Items))
+		if len(source.Items) >= 1000 {
+			workers := runtime.GOMAXPROCS(0)
+			chunk := (len(source.Items) + workers - 1) / workers
+			panics := make([]any, workers)
+			errs := make([]error, workers)
+			var wg sync.WaitGroup
+			for worker := 0; worker*chunk < len(source.Items); worker++ {
+				start := worker * chunk
+				end := start + chunk
+				if end > len(source.Items) {
+					end = len(source.Items)
+				}
+				wg.Add(1)
+				go func(worker, start, end int) {
+					defer wg.Done()
+					defer func() {
+						panics[worker] = recover()
+					}()
+					_, errs[worker] = func() (_ execution.OutputList, _ error) {
+						for i := start; i < end; i++ {
+							structsOutput, err := c.structsInputToStructsOutput(source.Items[i])
+							if err != nil {
+								return structsOutputList, err
+							}
+							structsOutputList.Items[i] = structsOutput
+						}
+						return
+					}()
+				}(worker, start, end)
+			}
+			wg.Wait()
+			for _, p := range panics {
+				if p != nil {
+					panic(p)
+				}
+			}
+			for _, err := range errs {
+				if err != nil {
+					return structsOutputList, err
+				}
+			}
+		} else {
+			for i := 0; i < len(source.Items); i++ {
+				structsOutput, err := c.structsInputToStructsOutput(source.Items[i])
+				if err != nil {
+					return structsOutputList, err
+				}
+				structsOutputList.Items[i] = structsOutput
+			}
+		}
+	}
+	return structsOutputList, nil
+}
+func (c *ConverterImpl) structsInputToStructsOutput(source execution.Input) (execution.Output, error) {
+	var structsOutput execution.Output
+	xint, err := execution.ParseAge(source.Age)
+	if err != nil {
+		return structsOutput, err
+	}
+	structsOutput.Age = xint
+	return structsOutput, nil
+}
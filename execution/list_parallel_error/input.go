@@ -0,0 +1,28 @@
+package structs
+
+// goverter:converter
+// goverter:extend ParseAge
+type Converter interface {
+    // goverter:list:parallel 1000
+    ConvertStruct(source InputList) (OutputList, error)
+}
+
+func ParseAge(s string) (int, error) {
+    return 0, nil
+}
+
+type InputList struct {
+    Items []Input
+}
+
+type OutputList struct {
+    Items []Output
+}
+
+type Input struct {
+    Age string
+}
+
+type Output struct {
+    Age int
+}
@@ -0,0 +1,129 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import (
+	execution "github.com/jmattheis/goverter/execution"
+	"runtime"
+	"sync"
+)
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source [][]execution.Input) [][]execution.Output {
+	var structsOutputListList [][]execution.Output
+	if source != nil {
+		structsOutputListList = make([][]execution.Output, len(source))
+		if len(source) >= 1000 {
+			workers2 := runtime.GOMAXPROCS(0)
+			chunk2 := (len(source) + workers2 - 1) / workers2
+			panics2 := make([]any, workers2)
+			var wg2 sync.WaitGroup
+			for worker2 := 0; worker2*chunk2 < len(source); worker2++ {
+				start2 := worker2 * chunk2
+				end2 := start2 + chunk2
+				if end2 > len(source) {
+					end2 = len(source)
+				}
+				wg2.Add(1)
+				go func(worker2, start2, end2 int) {
+					defer wg2.Done()
+					defer func() {
+						panics2[worker2] = recover()
+					}()
+					for i := start2; i < end2; i++ {
+						if source[i] != nil {
+							structsOutputListList[i] = make([]execution.Output, len(source[i]))
+							if len(source[i]) >= 1000 {
+								workers := runtime.GOMAXPROCS(0)
+								chunk := (len(source[i]) + workers - 1) / workers
+								panics := make([]any, workers)
+								var wg sync.WaitGroup
+								for worker := 0; worker*chunk < len(source[i]); worker++ {
+									start := worker * chunk
+									end := start + chunk
+									if end > len(source[i]) {
+										end = len(source[i])
+									}
+									wg.Add(1)
+									go func(worker, start, end int) {
+										defer wg.Done()
+										defer func() {
+											panics[worker] = recover()
+										}()
+										for j := start; j < end; j++ {
+											structsOutputListList[i][j] = c.structsInputToStructsOutput(source[i][j])
+										}
+									}(worker, start, end)
+								}
+								wg.Wait()
+								for _, p := range panics {
+									if p != nil {
+										panic(p)
+									}
+								}
+							} else {
+								for j := 0; j < len(source[i]); j++ {
+									structsOutputListList[i][j] = c.structsInputToStructsOutput(source[i][j])
+								}
+							}
+						}
+					}
+				}(worker2, start2, end2)
+			}
+			wg2.Wait()
+			for _, p2 := range panics2 {
+				if p2 != nil {
+					panic(p2)
+				}
+			}
+		} else {
+			for i := 0; i < len(source); i++ {
+				if source[i] != nil {
+					structsOutputListList[i] = make([]execution.Output, len(source[i]))
+					if len(source[i]) >= 1000 {
+						workers := runtime.GOMAXPROCS(0)
+						chunk := (len(source[i]) + workers - 1) / workers
+						panics := make([]any, workers)
+						var wg sync.WaitGroup
+						for worker := 0; worker*chunk < len(source[i]); worker++ {
+							start := worker * chunk
+							end := start + chunk
+							if end > len(source[i]) {
+								end = len(source[i])
+							}
+							wg.Add(1)
+							go func(worker, start, end int) {
+								defer wg.Done()
+								defer func() {
+									panics[worker] = recover()
+								}()
+								for j := start; j < end; j++ {
+									structsOutputListList[i][j] = c.structsInputToStructsOutput(source[i][j])
+								}
+							}(worker, start, end)
+						}
+						wg.Wait()
+						for _, p := range panics {
+							if p != nil {
+								panic(p)
+							}
+						}
+					} else {
+						for j := 0; j < len(source[i]); j++ {
+							structsOutputListList[i][j] = c.structsInputToStructsOutput(source[i][j])
+						}
+					}
+				}
+			}
+		}
+	}
+	return structsOutputListList
+}
+func (c *ConverterImpl) structsInputToStructsOutput(source execution.Input) execution.Output {
+	var structsOutput execution.Output
+	structsOutput.Name = source.Name
+	return structsOutput
+}
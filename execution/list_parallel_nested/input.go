@@ -0,0 +1,15 @@
+package structs
+
+// goverter:converter
+// goverter:list:parallel 1000
+type Converter interface {
+    Convert(source [][]Input) [][]Output
+}
+
+type Input struct {
+    Name string
+}
+
+type Output struct {
+    Name string
+}
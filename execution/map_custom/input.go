@@ -0,0 +1,20 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:map . FullName | ExtendFullName
+    Convert(source Input) Output
+}
+
+type Input struct {
+    ID int
+    FirstName string
+    LastName string
+}
+type Output struct {
+    ID int
+    FullName string
+}
+func ExtendFullName(source Input) string {
+    return source.FirstName + " " + source.LastName
+}
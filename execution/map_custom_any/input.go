@@ -0,0 +1,23 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:map V1 | AssignableSource
+    // goverter:map V2 | AssignableTarget
+    Convert(source Input) Output
+}
+
+type Input struct {
+    V1 interface{}
+    V2 bool
+}
+type Output struct {
+    V1 bool
+    V2 interface{}
+}
+func AssignableSource(source any) bool {
+    return true
+}
+func AssignableTarget(source bool) any {
+    return true
+}
@@ -0,0 +1,18 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:map Age | DefaultAge
+    Convert(source Input) Output
+}
+
+type Input struct {
+    Name string
+}
+type Output struct {
+    Name string
+    Age int
+}
+func DefaultAge() int {
+    return 42
+}
@@ -0,0 +1,16 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:map . Age | PluckAgeInt
+    Convert(source Input) Output
+}
+
+type Input struct {
+    Name string
+}
+type Output struct {
+    Name string
+    Age int
+}
+const PluckAgeInt = 24
@@ -0,0 +1,18 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:map . Score | DefaultName
+    Convert(source Input) Output
+}
+
+type Input struct {
+    Name string
+}
+type Output struct {
+    Name string
+    Score int
+}
+func DefaultName() string {
+    return "Joe"
+}
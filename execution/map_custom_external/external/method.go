@@ -0,0 +1,4 @@
+package external
+func IntToString(int) string {
+    return ""
+}
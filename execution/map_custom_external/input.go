@@ -0,0 +1,14 @@
+package execution
+
+// goverter:converter
+type Converter interface {
+    // goverter:map Age | github.com/jmattheis/goverter/execution/external:IntToString
+    Convert(source Input) Output
+}
+
+type Input struct {
+    Age   int
+}
+type Output struct {
+    Age   string
+}
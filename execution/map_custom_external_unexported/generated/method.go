@@ -0,0 +1,4 @@
+package generated
+func intToString(int) string {
+    return ""
+}
@@ -0,0 +1,15 @@
+package execution
+
+// goverter:converter
+// goverter:output:package github.com/jmattheis/goverter/execution/generated
+type Converter interface {
+    // goverter:map Age | github.com/jmattheis/goverter/execution/generated:intToString
+    Convert(source Input) Output
+}
+
+type Input struct {
+    Age   int
+}
+type Output struct {
+    Age   string
+}
@@ -0,0 +1,4 @@
+package external
+func intToString(int) string {
+    return ""
+}
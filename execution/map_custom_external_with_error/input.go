@@ -0,0 +1,14 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:map Age | strconv:Atoi
+    Convert(source Input) (Output, error)
+}
+
+type Input struct {
+    Age string
+}
+type Output struct {
+    Age int
+}
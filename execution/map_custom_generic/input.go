@@ -0,0 +1,14 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:map ID | ZeroIfNil
+    Convert(source Input) Output
+}
+
+type Input struct { ID *int }
+type Output struct { ID int }
+func ZeroIfNil[T any](*T) T {
+    var t T
+    return t
+}
@@ -0,0 +1,24 @@
+package structs
+
+import (
+    "fmt"
+    "time"
+)
+
+// goverter:converter
+type Converter interface {
+    // goverter:map V1 | ToString
+    // goverter:map V2 | time:Now
+    Convert(source Input) Output
+}
+
+type Input struct {
+    V1 time.Time
+}
+type Output struct {
+    V1 string
+    V2 fmt.Stringer
+}
+func ToString(s fmt.Stringer) string {
+    return s.String()
+}
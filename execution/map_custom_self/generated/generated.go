@@ -0,0 +1,49 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import execution "github.com/jmattheis/goverter/execution"
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source *execution.Input) *execution.Output {
+	var pStructsOutput *execution.Output
+	if source != nil {
+		var structsOutput execution.Output
+		structsOutput.Animals = execution.Combine(c, (*source))
+		pStructsOutput = &structsOutput
+	}
+	return pStructsOutput
+}
+func (c *ConverterImpl) ConvertCats(source []execution.Cat) []execution.Animal {
+	var structsAnimalList []execution.Animal
+	if source != nil {
+		structsAnimalList = make([]execution.Animal, len(source))
+		for i := 0; i < len(source); i++ {
+			structsAnimalList[i] = c.structsCatToStructsAnimal(source[i])
+		}
+	}
+	return structsAnimalList
+}
+func (c *ConverterImpl) ConvertDogs(source []execution.Dog) []execution.Animal {
+	var structsAnimalList []execution.Animal
+	if source != nil {
+		structsAnimalList = make([]execution.Animal, len(source))
+		for i := 0; i < len(source); i++ {
+			structsAnimalList[i] = c.structsDogToStructsAnimal(source[i])
+		}
+	}
+	return structsAnimalList
+}
+func (c *ConverterImpl) structsCatToStructsAnimal(source execution.Cat) execution.Animal {
+	var structsAnimal execution.Animal
+	structsAnimal.Name = source.Name
+	return structsAnimal
+}
+func (c *ConverterImpl) structsDogToStructsAnimal(source execution.Dog) execution.Animal {
+	var structsAnimal execution.Animal
+	structsAnimal.Name = source.Name
+	return structsAnimal
+}
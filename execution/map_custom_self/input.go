@@ -0,0 +1,27 @@
+package structs
+// goverter:converter
+type Converter interface {
+    // goverter:map . Animals | Combine
+    Convert(source *Input) *Output
+    ConvertDogs([]Dog) []Animal
+    ConvertCats([]Cat) []Animal
+}
+
+type Input struct {
+    Cats []Cat
+    Dogs []Dog
+}
+type Output struct {
+    Animals []Animal
+}
+
+type Cat struct { Name string }
+type Dog struct { Name string }
+
+type Animal struct { Name string }
+
+func Combine(c Converter, input Input) []Animal {
+    dogs := c.ConvertDogs(input.Dogs)
+    cats := c.ConvertCats(input.Cats)
+    return append(dogs, cats...)
+}
@@ -0,0 +1,17 @@
+package structs
+// goverter:converter
+type Converter interface {
+    // goverter:map . Age | DefaultAge
+    Convert(source *Input) *Output
+}
+type Input struct {
+    Name string
+    Score int
+}
+type Output struct {
+    Name string
+    Age int
+}
+func DefaultAge() int {
+    return 42
+}
@@ -0,0 +1,15 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:map ID | Check
+    Convert(source Input) Output
+}
+
+type ID = int
+
+type Input struct { ID ID }
+type Output struct { ID bool }
+func Check(source int) bool {
+    return true
+}
@@ -0,0 +1,22 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import execution "github.com/jmattheis/goverter/execution"
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) ConvertPerson(source execution.Person) execution.APIPerson {
+	var structsAPIPerson execution.APIPerson
+	structsAPIPerson.Name = source.Name
+	structsAPIPerson.Address = c.structsPersonToStructsAPIAddress(source)
+	return structsAPIPerson
+}
+func (c *ConverterImpl) structsPersonToStructsAPIAddress(source execution.Person) execution.APIAddress {
+	var structsAPIAddress execution.APIAddress
+	structsAPIAddress.Street = source.Street
+	structsAPIAddress.City = source.City
+	return structsAPIAddress
+}
@@ -0,0 +1,23 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:map . Address
+    ConvertPerson(source Person) APIPerson
+}
+
+type Person struct {
+    Name   string
+    Street string
+    City   string
+}
+
+type APIPerson struct {
+    Name    string
+    Address APIAddress
+}
+
+type APIAddress struct {
+    Street string
+    City   string
+}
@@ -0,0 +1,30 @@
+package structs
+
+// goverter:converter
+// goverter:extend strconv:Atoi
+type Converter interface {
+    Convert(source []Person) ([]APIPerson, error)
+
+    // goverter:map . Address
+    ConvertPerson(source Person) (APIPerson, error)
+
+    ConvertAddress(source Person) (APIAddress, error)
+}
+
+type Person struct {
+    Name       string
+    Street     string
+    City       string
+    PostalCode string
+}
+
+type APIPerson struct {
+    Name    string
+    Address *APIAddress
+}
+
+type APIAddress struct {
+    Street     string
+    City       string
+    PostalCode int
+}
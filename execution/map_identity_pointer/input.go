@@ -0,0 +1,26 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:map . Address
+    ConvertPerson(source Person) (APIPerson, error)
+
+    // goverter:map Name StreetName
+    ConvertAddress(source Person) (APIAddress, error)
+}
+
+type Person struct {
+    Name   string
+    Street string
+    City   string
+}
+
+type APIPerson struct {
+    Name    string
+    Address *APIAddress
+}
+
+type APIAddress struct {
+    StreetName string
+    City       string
+}
@@ -0,0 +1,25 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import execution "github.com/jmattheis/goverter/execution"
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source *execution.In) *execution.Out {
+	var pStructsOut *execution.Out
+	if source != nil {
+		var structsOut execution.Out
+		structsOut.Base = c.structsInToStructsBase((*source))
+		structsOut.Age = (*source).Age
+		pStructsOut = &structsOut
+	}
+	return pStructsOut
+}
+func (c *ConverterImpl) structsInToStructsBase(source execution.In) execution.Base {
+	var structsBase execution.Base
+	structsBase.Name = source.Name
+	return structsBase
+}
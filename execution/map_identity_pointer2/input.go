@@ -0,0 +1,21 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:map . Base
+    Convert(in *In) *Out
+}
+
+type In struct {
+    Name string
+    Age  int
+}
+
+type Out struct {
+    Base
+    Age int
+}
+
+type Base struct {
+    Name string
+}
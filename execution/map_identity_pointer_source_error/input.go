@@ -0,0 +1,20 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:map . FullName | ToFullName
+    ConvertPerson(source Person) APIPerson
+}
+
+type Person struct {
+    FirstName string
+    LastName  string
+}
+
+type APIPerson struct {
+    FullName string
+}
+
+func ToFullName(input *Person) string {
+    return input.FirstName + " " + input.LastName
+}
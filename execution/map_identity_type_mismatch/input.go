@@ -0,0 +1,25 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:map . Address
+    ConvertPerson(source Person) APIPerson
+}
+
+type Person struct {
+    Name   string
+    Civic  string
+    Street string
+    City   *string
+}
+
+type APIPerson struct {
+    Name    string
+    Address APIAddress
+}
+
+type APIAddress struct {
+    Civic  string
+    Street string
+    City   string
+}
@@ -0,0 +1,19 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:map . Absolute | ToAbsolute
+    ConvertPerson(source Input) Output
+}
+
+type Input struct {
+    Relative string
+}
+
+type Output struct {
+    Absolute string
+}
+
+func ToAbsolute(i Input) string {
+    return "https://example.org/" + i.Relative
+}
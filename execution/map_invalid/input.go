@@ -0,0 +1,15 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:map | Malformed
+    ConvertPerson(source Person) APIPerson
+}
+
+type Person struct {
+    Name   string
+}
+
+type APIPerson struct {
+    Name    string
+}
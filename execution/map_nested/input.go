@@ -0,0 +1,27 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:map Address.Civic Civic
+    // goverter:map Address.Street Street
+    // goverter:map Address.City City
+    ConvertPerson(source Person) APIPerson
+}
+
+type Person struct {
+    Name    string
+    Address Address
+}
+
+type Address struct {
+    Civic  string
+    Street string
+    City   string
+}
+
+type APIPerson struct {
+    Name   string
+    Civic  string
+    Street string
+    City   string
+}
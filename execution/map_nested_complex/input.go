@@ -0,0 +1,23 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:map Nested.Inner.Inner2.Name Name
+    Convert(source Input) Output
+}
+
+type Input struct {
+    Nested *InputNested
+}
+type InputNested struct {
+    Inner InputNestedNested
+}
+type InputNestedNested struct {
+    Inner2 *InputNestedNestedNested
+}
+type InputNestedNestedNested struct {
+    Name *string
+}
+type Output struct {
+    Name *string
+}
@@ -0,0 +1,17 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:map Nested.Name.Oops Name
+    Convert(source Input) Output
+}
+
+type Input struct {
+    Nested Nested
+}
+type Nested struct {
+    Name int
+}
+type Output struct {
+    Name string
+}
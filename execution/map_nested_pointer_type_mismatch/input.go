@@ -0,0 +1,17 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:map Nested.Name Name
+    Convert(source Input) Output
+}
+
+type Input struct {
+    Nested *Nested
+}
+type Nested struct {
+    Name string
+}
+type Output struct {
+    Name string
+}
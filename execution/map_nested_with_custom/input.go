@@ -0,0 +1,25 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:map A.B.Relative Absolute | ToAbsolute
+    ConvertPerson(source Input) Output
+}
+
+type Input struct {
+    A A
+}
+type A struct {
+    B B
+}
+type B struct {
+    Relative string
+}
+
+type Output struct {
+    Absolute string
+}
+
+func ToAbsolute(s string) string {
+    return "https://example.org/" + s
+}
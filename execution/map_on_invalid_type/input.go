@@ -0,0 +1,15 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:map Lastname Surname
+    ConvertPerson(source []Person) []APIPerson
+}
+
+type Person struct {
+    Lastname string
+}
+
+type APIPerson struct {
+    Surename string
+}
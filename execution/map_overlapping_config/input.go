@@ -0,0 +1,18 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:ignore Age
+    // goverter:map Name Name
+    Convert(source *Input) (*Output)
+
+    ConvertSub(source Input) (Output)
+}
+
+type Input struct {
+    Name string
+}
+type Output struct {
+    Name string
+    Age int
+}
@@ -0,0 +1,16 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:ignore Name
+    Convert(source *Input) (*Output)
+
+    ConvertSub(source Input) (*Output)
+}
+
+type Input struct {
+    Name string
+}
+type Output struct {
+    Name string
+}
@@ -0,0 +1,13 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:map Name FullName
+    Convert(source Input) *Output
+}
+type Input struct {
+    Name string
+}
+type Output struct {
+    FullName string
+}
@@ -0,0 +1,19 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:map Name Nested.Name
+    Convert(source Input) Output
+}
+
+type Input struct {
+    Name string
+    Age int
+}
+type Output struct {
+    Age int
+    Nested Nested
+}
+type Nested struct {
+    Name string
+}
@@ -0,0 +1,9 @@
+package err
+
+type (
+    // goverter:converter
+    Converter interface {
+        // goverter:map a b c
+        ConvertString(source string) string
+    }
+)
@@ -0,0 +1,17 @@
+package structs
+
+// goverter:converter
+// goverter:output:file ./generated.go
+// goverter:output:package github.com/jmattheis/goverter/execution:structs
+type Converter interface {
+    // goverter:map relative absolute
+    ConvertPerson(source Input) Output
+}
+
+type Input struct {
+    relative string
+}
+
+type Output struct {
+    absolute string
+}
@@ -0,0 +1,16 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:map Name Typo
+    Convert(source Input) Output
+}
+
+type Input struct {
+    Name string
+    Age int
+}
+type Output struct {
+    Age int
+    Name string
+}
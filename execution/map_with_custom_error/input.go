@@ -0,0 +1,19 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:map Relative Absolute | Enhance
+    ConvertPerson(source Input) Output
+}
+
+type Input struct {
+    Relative string
+}
+
+type Output struct {
+    Absolute string
+}
+
+func Enhance(s int) string {
+    return "https://example.org/"
+}
@@ -0,0 +1,18 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:matchIgnoreCase
+    Convert(source Input) Output
+}
+
+type Input struct {
+    Name string
+    UUID string
+    OtherID int
+}
+type Output struct {
+    Name string
+    Uuid string
+    OtherId int
+}
@@ -0,0 +1,26 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:matchIgnoreCase
+    // goverter:map AmbID AmbId
+    // goverter:map AmbID2 AmbId2
+    Convert(source Input) Output
+}
+
+type Input struct {
+    OtherID string
+    OtherId int // should prefer exact match
+    Uuid string
+    AmbID float64 // explicit choice
+    Ambid float64
+    AmbID2 string
+    Ambid2 string
+}
+type Output struct {
+    OtherId int
+    Uuid string // should prefer exact match
+    UUID string
+    AmbId float64
+    AmbId2 string
+}
@@ -0,0 +1,7 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:matchIgnoreCase a
+    ConvertString(source string) string
+}
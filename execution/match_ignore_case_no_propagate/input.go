@@ -0,0 +1,22 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:matchIgnoreCase
+    Convert(source Input) Output
+}
+
+type Input struct {
+    UUID string
+    Nested InputNested
+}
+type InputNested struct {
+    ID string
+}
+type Output struct {
+    Uuid string
+    Nested OutputNested
+}
+type OutputNested struct {
+    Id string
+}
@@ -0,0 +1,20 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:matchIgnoreCase
+    Convert(source Input) Output
+}
+
+type Input struct {
+    NESTED InputNested
+}
+type InputNested struct {
+    Name string
+}
+type Output struct {
+    Nested OutputNested
+}
+type OutputNested struct {
+    Name int
+}
@@ -0,0 +1,15 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:matchIgnoreCase
+    Convert(source Input) Output
+}
+
+type Input struct {
+    MyID string
+    MyId string
+}
+type Output struct {
+    Myid string
+}
@@ -0,0 +1,19 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+type Converter3Impl struct{}
+
+var Converter3Convert = Converter3Impl{}
+
+type Converter4Impl struct{}
+
+var Converter4Convert = Converter4Impl{}
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+type RenamedConverter struct{}
+
+var RenamedConverterConvert = RenamedConverter{}
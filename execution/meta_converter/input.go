@@ -0,0 +1,23 @@
+package meta
+
+// goverter:converter
+type Converter interface {
+}
+
+// goverter:converter
+// goverter:name RenamedConverter
+type (
+    Converter2 interface {
+    }
+)
+
+type Opps struct {}
+
+type (
+    // goverter:converter
+    Converter3 interface {
+    }
+    // goverter:converter
+    Converter4 interface {
+    }
+)
@@ -0,0 +1,16 @@
+package slices
+
+// goverter:converter
+type Converter interface {
+    Convert(source []Input) []Output
+    ConvertX(string) (string, error)
+}
+
+type Input struct {
+    Name string
+    Age int
+}
+type Output struct {
+    Name string
+    Age int
+}
@@ -0,0 +1,8 @@
+package model
+
+type Input struct {
+    ID int
+}
+type Output struct {
+    ID int
+}
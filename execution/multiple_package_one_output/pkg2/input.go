@@ -0,0 +1,9 @@
+package pkg2
+
+import model "github.com/jmattheis/goverter/execution/model"
+
+// goverter:converter
+// goverter:output:file ../generated/output.go
+type Converter2 interface {
+    Convert(source model.Input) model.Output
+}
@@ -0,0 +1,6 @@
+package err
+
+// goverter:converter
+// goverter:name a b
+type Converter interface {
+}
@@ -0,0 +1,21 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import execution "github.com/jmattheis/goverter/execution"
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source execution.DBModel) execution.ApiModel {
+	var structsApiModel execution.ApiModel
+	structsApiModel.Inner = c.structsDBInnerToStructsApiInner(source.Inner)
+	return structsApiModel
+}
+func (c *ConverterImpl) structsDBInnerToStructsApiInner(source execution.DBInner) execution.ApiInner {
+	var structsApiInner execution.ApiInner
+	structsApiInner.Name = source.Name
+	structsApiInner.Value = source.Value
+	return structsApiInner
+}
@@ -0,0 +1,18 @@
+package alias
+
+// goverter:converter
+// goverter:extend AnyAny
+type Converter interface {
+    A(source map[string]InputAlias) map[string]OutputAlias
+    B(source map[string]any) map[string]any
+    C(source struct{A InputAlias}) struct{A OutputAlias}
+    D(source []GenAlias[string]) []GenAlias[string]
+}
+
+func AnyAny(a any) any {
+    return a
+}
+
+type GenAlias[T any] []T
+type InputAlias = string
+type OutputAlias = string
@@ -0,0 +1,22 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    Convert(source DBModel) ApiModel
+}
+
+type DBModel struct {
+    Inner *DBInner
+}
+type DBInner struct {
+    Name string
+    Value int
+}
+
+type ApiModel struct {
+    Inner *ApiInner
+}
+type ApiInner struct {
+    Name string
+    Value int
+}
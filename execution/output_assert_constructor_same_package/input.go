@@ -0,0 +1,19 @@
+package structs
+
+// goverter:converter
+// goverter:name Impl
+// goverter:output:file ./generated.go
+// goverter:output:package :structs
+// goverter:output:assert
+// goverter:output:constructor
+type Converter interface {
+    Convert(source Input) Output
+}
+
+type Input struct {
+    Name string
+}
+
+type Output struct {
+    Name string
+}
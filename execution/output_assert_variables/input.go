@@ -0,0 +1,7 @@
+package structs
+
+// goverter:variables
+// goverter:output:assert
+var (
+    Convert func(source string) string
+)
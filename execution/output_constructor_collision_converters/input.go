@@ -0,0 +1,13 @@
+package constructor
+
+// goverter:converter
+// goverter:output:constructor
+type A interface {
+    Convert(source string) string
+}
+
+// goverter:converter
+// goverter:output:constructor:name NewA
+type B interface {
+    Convert(source int) int
+}
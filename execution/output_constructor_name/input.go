@@ -0,0 +1,11 @@
+package constructor
+
+// goverter:converter
+// goverter:output:file ./generated.go
+// goverter:output:package :constructor
+// goverter:output:constructor:name NewGeneratedConverter
+type Converter interface {
+    Convert(source string) string
+}
+
+func NewConverter() {}
@@ -0,0 +1,7 @@
+package constructor
+
+// goverter:converter
+// goverter:output:constructor:name New-Converter
+type Converter interface {
+    Convert(source string) string
+}
@@ -0,0 +1,41 @@
+package execution
+
+// goverter:converter
+// goverter:output:docs ./docs/mapping.html
+// goverter:enum:unknown @panic
+type Converter interface {
+    // goverter:map Nested.Name NestedName
+    // goverter:ignore Internal
+    Convert(source Input) Output
+    // goverter:enum:map InputRed OutputRed
+    // goverter:enum:map InputGray @ignore
+    ConvertColor(source InputColor) OutputColor
+}
+
+type Input struct {
+    ID     int
+    Nested InputNested
+    Color  InputColor
+}
+type InputNested struct {
+    Name string
+}
+type Output struct {
+    ID         int
+    NestedName string
+    Internal   string
+    Color      OutputColor
+}
+
+type InputColor int
+
+const (
+    InputRed InputColor = iota
+    InputGray
+)
+
+type OutputColor string
+
+const (
+    OutputRed OutputColor = "red"
+)
@@ -0,0 +1,14 @@
+package execution
+
+// goverter:converter
+// goverter:output:docs ./docs/mapping.txt
+type Converter interface {
+    Convert(source Input) Output
+}
+
+type Input struct {
+    Name string
+}
+type Output struct {
+    Name string
+}
@@ -0,0 +1,31 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	execution "github.com/jmattheis/goverter/execution"
+)
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source execution.Input) execution.Output {
+	var executionOutput execution.Output
+	executionOutput.ID = source.ID
+	executionOutput.NestedName = source.Nested.Name
+	executionOutput.Color = c.ConvertColor(source.Color)
+	return executionOutput
+}
+func (c *ConverterImpl) ConvertColor(source execution.InputColor) execution.OutputColor {
+	var executionOutputColor execution.OutputColor
+	switch source {
+	case execution.InputGray: // ignored
+	case execution.InputRed:
+		executionOutputColor = execution.OutputRed
+	default:
+		panic(fmt.Sprintf("unexpected enum element: %v", source))
+	}
+	return executionOutputColor
+}
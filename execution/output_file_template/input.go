@@ -0,0 +1,19 @@
+package structs
+
+// goverter:converter
+type UserConverter interface {
+    Convert(source Input) Output
+}
+
+// goverter:converter
+type HTTPConverter interface {
+    Convert(source Input) Output
+}
+
+type Input struct {
+    Name string
+}
+
+type Output struct {
+    Name string
+}
@@ -0,0 +1,16 @@
+package structs
+
+// goverter:converter
+// goverter:output:file ./{{.FileBase}}_gen.go
+// goverter:output:package :structs
+type Converter interface {
+    Convert(source Input) Output
+}
+
+type Input struct {
+    Name string
+}
+
+type Output struct {
+    Name string
+}
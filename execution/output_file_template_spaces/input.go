@@ -0,0 +1,7 @@
+package structs
+
+// goverter:converter
+// goverter:output:file ./generated/{{ .InterfaceSnake }}_gen.go
+type UserConverter interface {
+    Convert(source string) string
+}
@@ -0,0 +1,6 @@
+package structs
+
+// goverter:variables
+var (
+    Convert func(source string) string
+)
@@ -0,0 +1,15 @@
+package structs
+
+// goverter:converter
+// goverter:output:header Copyright 2024 Example Corp. Package {{.Package}}, converter {{.Converter}}.
+type Converter interface {
+    Convert(source Input) Output
+}
+
+type Input struct {
+    Name string
+}
+
+type Output struct {
+    Name string
+}
@@ -0,0 +1,21 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    Convert(source Input) Output
+}
+
+// goverter:converter
+// goverter:output:header Overridden for {{.Converter}}
+// goverter:output:file ./other/generated.go
+type OtherConverter interface {
+    Convert(source Input) Output
+}
+
+type Input struct {
+    Name string
+}
+
+type Output struct {
+    Name string
+}
@@ -0,0 +1,17 @@
+// Overridden for OtherConverterImpl
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+//go:build !goverter
+
+package other
+
+import execution "github.com/jmattheis/goverter/execution"
+
+type OtherConverterImpl struct{}
+
+var OtherConverterConvert = OtherConverterImpl{}
+
+func (c *OtherConverterImpl) Convert(source execution.Input) execution.Output {
+	var structsOutput execution.Output
+	structsOutput.Name = source.Name
+	return structsOutput
+}
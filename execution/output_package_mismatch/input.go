@@ -0,0 +1,20 @@
+package structs
+
+// goverter:converter
+// goverter:output:package pkg1
+type Converter interface {
+    Convert(source Input) Output
+}
+
+// goverter:converter
+// goverter:output:package pkg2
+type Converter2 interface {
+    Convert(source Input) Output
+}
+
+type Input struct {
+    ID int
+}
+type Output struct {
+    ID int
+}
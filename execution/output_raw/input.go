@@ -0,0 +1,12 @@
+package structs
+
+// goverter:converter
+// goverter:output:raw func hello() {
+// goverter:output:raw     println("hello world")
+// goverter:output:raw }
+type Converter interface {
+    Convert(source Input) Output
+}
+
+type Input struct { Name string }
+type Output struct { Name string }
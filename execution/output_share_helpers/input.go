@@ -0,0 +1,21 @@
+package structs
+
+// goverter:converter
+// goverter:output:shareHelpers
+type ListConverter interface {
+    ConvertList(source []Input) []Output
+}
+
+// goverter:converter
+// goverter:output:shareHelpers
+type MapConverter interface {
+    ConvertMap(source map[string]Input) map[string]Output
+}
+
+type Input struct {
+    Name string
+}
+
+type Output struct {
+    Name string
+}
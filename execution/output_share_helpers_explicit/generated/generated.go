@@ -0,0 +1,45 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import execution "github.com/jmattheis/goverter/execution"
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) ConvertInputs(source []execution.Input) []execution.Output {
+	var structsOutputList []execution.Output
+	if source != nil {
+		structsOutputList = make([]execution.Output, len(source))
+		for i := 0; i < len(source); i++ {
+			structsOutputList[i] = structsInputToStructsOutput(source[i])
+		}
+	}
+	return structsOutputList
+}
+func (c *ConverterImpl) ConvertItem(source execution.Item) execution.ItemOut {
+	var structsItemOut execution.ItemOut
+	structsItemOut.ID = source.ID
+	return structsItemOut
+}
+func (c *ConverterImpl) ConvertList(source []execution.Outer) []execution.OuterOut {
+	var structsOuterOutList []execution.OuterOut
+	if source != nil {
+		structsOuterOutList = make([]execution.OuterOut, len(source))
+		for i := 0; i < len(source); i++ {
+			structsOuterOutList[i] = c.structsOuterToStructsOuterOut(source[i])
+		}
+	}
+	return structsOuterOutList
+}
+func structsInputToStructsOutput(source execution.Input) execution.Output {
+	var structsOutput execution.Output
+	structsOutput.Name = source.Name
+	return structsOutput
+}
+func (c *ConverterImpl) structsOuterToStructsOuterOut(source execution.Outer) execution.OuterOut {
+	var structsOuterOut execution.OuterOut
+	structsOuterOut.Item = c.ConvertItem(source.Item)
+	return structsOuterOut
+}
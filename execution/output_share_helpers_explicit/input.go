@@ -0,0 +1,33 @@
+package structs
+
+// goverter:converter
+// goverter:output:shareHelpers
+type Converter interface {
+    ConvertItem(source Item) ItemOut
+    ConvertList(source []Outer) []OuterOut
+    ConvertInputs(source []Input) []Output
+}
+
+type Item struct {
+    ID int
+}
+
+type ItemOut struct {
+    ID int
+}
+
+type Outer struct {
+    Item Item
+}
+
+type OuterOut struct {
+    Item ItemOut
+}
+
+type Input struct {
+    Name string
+}
+
+type Output struct {
+    Name string
+}
@@ -0,0 +1,16 @@
+package structs
+
+// goverter:converter
+// goverter:output:format function
+// goverter:output:split type
+type Converter interface {
+    Convert(source []Input) []Output
+}
+
+type Input struct {
+    Name string
+}
+
+type Output struct {
+    Name string
+}
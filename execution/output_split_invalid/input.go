@@ -0,0 +1,7 @@
+package structs
+
+// goverter:converter
+// goverter:output:split file
+type Converter interface {
+    Convert(source string) string
+}
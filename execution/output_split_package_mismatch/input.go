@@ -0,0 +1,15 @@
+package structs
+
+// goverter:converter
+// goverter:output:file ./generated/a.go
+// goverter:output:split method
+type A interface {
+    B(source string) string
+}
+
+// goverter:converter
+// goverter:output:file ./generated/a_b_gen.go
+// goverter:output:package github.com/jmattheis/goverter/execution/generated:other
+type C interface {
+    Convert(source string) string
+}
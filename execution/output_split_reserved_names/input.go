@@ -0,0 +1,10 @@
+package structs
+
+// goverter:converter
+// goverter:output:split method
+type Converter interface {
+    Test(source []int) []int
+    Linux(source []string) []string
+    Windows(source []bool) []bool
+    Amd64(source []uint) []uint
+}
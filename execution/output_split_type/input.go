@@ -0,0 +1,31 @@
+package structs
+
+// goverter:converter
+// goverter:output:split type
+type Converter interface {
+    Convert(source []Input) []Output
+    ConvertPerson(source Person) APIPerson
+}
+
+type Input struct {
+    Name   string
+    Nested InputNested
+}
+type InputNested struct {
+    ID int
+}
+
+type Output struct {
+    Name   string
+    Nested OutputNested
+}
+type OutputNested struct {
+    ID int
+}
+
+type Person struct {
+    Name string
+}
+type APIPerson struct {
+    Name string
+}
@@ -0,0 +1,15 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package myname
+
+import execution "github.com/jmattheis/goverter/execution"
+
+type Converter2Impl struct{}
+
+var Converter2Convert = Converter2Impl{}
+
+func (c *Converter2Impl) Convert(source execution.Input) execution.Output {
+	var pkgOutput execution.Output
+	pkgOutput.ID = source.ID
+	return pkgOutput
+}
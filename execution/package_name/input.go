@@ -0,0 +1,14 @@
+package pkg
+
+// goverter:converter
+// goverter:output:package github.com/jmattheis/goverter/execution/generated:myname
+type Converter2 interface {
+    Convert(source Input) Output
+}
+
+type Input struct {
+    ID int
+}
+type Output struct {
+    ID int
+}
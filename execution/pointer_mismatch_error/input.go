@@ -0,0 +1,14 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    ConvertPerson(source Person) APIPerson
+}
+
+type Person struct {
+    FirstName *string
+}
+
+type APIPerson struct {
+    FirstName string
+}
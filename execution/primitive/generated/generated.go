@@ -0,0 +1,84 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import execution "github.com/jmattheis/goverter/execution"
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source execution.ID) execution.OtherID {
+	return execution.OtherID(source)
+}
+func (c *ConverterImpl) Convert2(source execution.ID) *execution.OtherID {
+	pPrimitiveOtherID := c.Convert(source)
+	return &pPrimitiveOtherID
+}
+func (c *ConverterImpl) Convert3(source int) *execution.OtherID {
+	pPrimitiveOtherID := execution.OtherID(source)
+	return &pPrimitiveOtherID
+}
+func (c *ConverterImpl) Convert4(source execution.ID) int {
+	return int(source)
+}
+func (c *ConverterImpl) ConvertBool(source bool) bool {
+	return source
+}
+func (c *ConverterImpl) ConvertComplex128(source complex128) complex128 {
+	return source
+}
+func (c *ConverterImpl) ConvertComplex64(source complex64) complex64 {
+	return source
+}
+func (c *ConverterImpl) ConvertFloat32(source float32) float32 {
+	return source
+}
+func (c *ConverterImpl) ConvertFloat64(source float64) float64 {
+	return source
+}
+func (c *ConverterImpl) ConvertInt(source int) int {
+	return source
+}
+func (c *ConverterImpl) ConvertInt16(source int16) int16 {
+	return source
+}
+func (c *ConverterImpl) ConvertInt32(source int32) int32 {
+	return source
+}
+func (c *ConverterImpl) ConvertInt64(source int64) int64 {
+	return source
+}
+func (c *ConverterImpl) ConvertInt8(source int8) int8 {
+	return source
+}
+func (c *ConverterImpl) ConvertPPString(source *string) *string {
+	var pString *string
+	if source != nil {
+		xstring := c.ConvertString(*source)
+		pString = &xstring
+	}
+	return pString
+}
+func (c *ConverterImpl) ConvertPString(source string) *string {
+	pString := c.ConvertString(source)
+	return &pString
+}
+func (c *ConverterImpl) ConvertString(source string) string {
+	return source
+}
+func (c *ConverterImpl) ConvertUint(source uint) uint {
+	return source
+}
+func (c *ConverterImpl) ConvertUint16(source uint16) uint16 {
+	return source
+}
+func (c *ConverterImpl) ConvertUint32(source uint32) uint32 {
+	return source
+}
+func (c *ConverterImpl) ConvertUint64(source uint64) uint64 {
+	return source
+}
+func (c *ConverterImpl) ConvertUint8(source uint8) uint8 {
+	return source
+}
@@ -0,0 +1,31 @@
+package primitive
+
+// goverter:converter
+type Converter interface {
+    ConvertString(source string) string
+    ConvertInt(source int) int
+    ConvertInt8(source int8) int8
+    ConvertInt16(source int16) int16
+    ConvertInt32(source int32) int32
+    ConvertInt64(source int64) int64
+    ConvertUint(source uint) uint
+    ConvertUint8(source uint8) uint8
+    ConvertUint16(source uint16) uint16
+    ConvertUint32(source uint32) uint32
+    ConvertUint64(source uint64) uint64
+    ConvertBool(source bool) bool
+    ConvertComplex128(source complex128) complex128
+    ConvertComplex64(source complex64) complex64
+    ConvertFloat32(source float32) float32
+    ConvertFloat64(source float64) float64
+
+    ConvertPString(source string) *string
+    ConvertPPString(source *string) *string
+
+    Convert(source ID) OtherID
+    Convert2(source ID) *OtherID
+    Convert3(source int) *OtherID
+    Convert4(source ID) int
+}
+type ID int
+type OtherID int
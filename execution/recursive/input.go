@@ -0,0 +1,17 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    Convert(source Input) Output
+}
+
+type Input struct {
+    Name string
+    Age int
+    Value *Input
+}
+type Output struct {
+    Name string
+    Age int
+    Value *Output
+}
@@ -0,0 +1,33 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import execution "github.com/jmattheis/goverter/execution"
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source execution.Input) execution.Output {
+	var structsOutput execution.Output
+	structsOutput.Value = c.pStructsInputAToPStructsOutputA(source.Value)
+	return structsOutput
+}
+func (c *ConverterImpl) pStructsInputAToPStructsOutputA(source *execution.InputA) *execution.OutputA {
+	var pStructsOutputA *execution.OutputA
+	if source != nil {
+		var structsOutputA execution.OutputA
+		structsOutputA.Value = c.pStructsInputBToPStructsOutputB((*source).Value)
+		pStructsOutputA = &structsOutputA
+	}
+	return pStructsOutputA
+}
+func (c *ConverterImpl) pStructsInputBToPStructsOutputB(source *execution.InputB) *execution.OutputB {
+	var pStructsOutputB *execution.OutputB
+	if source != nil {
+		var structsOutputB execution.OutputB
+		structsOutputB.Value = c.pStructsInputAToPStructsOutputA((*source).Value)
+		pStructsOutputB = &structsOutputB
+	}
+	return pStructsOutputB
+}
@@ -0,0 +1,26 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    Convert(source Input) Output
+}
+
+type Input struct {
+    Value *InputA
+}
+type Output struct {
+    Value *OutputA
+}
+
+type InputA struct {
+    Value *InputB
+}
+type InputB struct {
+    Value *InputA
+}
+type OutputA struct {
+    Value *OutputB
+}
+type OutputB struct {
+    Value *OutputA
+}
@@ -0,0 +1,21 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import execution "github.com/jmattheis/goverter/execution"
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source *execution.Input) *execution.Output {
+	var pStructsOutput *execution.Output
+	if source != nil {
+		var structsOutput execution.Output
+		structsOutput.Name = (*source).Name
+		structsOutput.Age = (*source).Age
+		structsOutput.Value = c.Convert((*source).Value)
+		pStructsOutput = &structsOutput
+	}
+	return pStructsOutput
+}
@@ -0,0 +1,29 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import execution "github.com/jmattheis/goverter/execution"
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source *execution.Input) *execution.Output {
+	var pStructsOutput *execution.Output
+	if source != nil {
+		structsOutput := c.structsInputToStructsOutput((*source))
+		pStructsOutput = &structsOutput
+	}
+	return pStructsOutput
+}
+func (c *ConverterImpl) structsInputToStructsOutput(source execution.Input) execution.Output {
+	var structsOutput execution.Output
+	structsOutput.Name = source.Name
+	if source.Values != nil {
+		structsOutput.Values = make([]execution.Output, len(source.Values))
+		for i := 0; i < len(source.Values); i++ {
+			structsOutput.Values[i] = c.structsInputToStructsOutput(source.Values[i])
+		}
+	}
+	return structsOutput
+}
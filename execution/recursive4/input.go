@@ -0,0 +1,16 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    Convert(source *Input) *Output
+}
+
+type Input struct {
+    Name   string
+    Values []Input
+}
+
+type Output struct {
+    Name   string
+    Values []Output
+}
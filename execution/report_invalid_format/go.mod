@@ -0,0 +1,2 @@
+module github.com/jmattheis/goverter/execution
+go 1.18
\ No newline at end of file
@@ -0,0 +1,41 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import execution "github.com/jmattheis/goverter/execution"
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) ConvertItems(source []execution.Input) []execution.Output {
+	var executionOutputList []execution.Output
+	if source != nil {
+		executionOutputList = make([]execution.Output, len(source))
+		for i := 0; i < len(source); i++ {
+			executionOutputList[i] = c.executionInputToExecutionOutput(source[i])
+		}
+	}
+	return executionOutputList
+}
+func (c *ConverterImpl) Update(source execution.Input, target *execution.Output) {
+	if source.Name != "" {
+		target.Name = source.Name
+	}
+	target.Nested = c.pExecutionInputNestedToPExecutionOutputNested(source.Nested)
+}
+func (c *ConverterImpl) executionInputToExecutionOutput(source execution.Input) execution.Output {
+	var executionOutput execution.Output
+	executionOutput.Name = source.Name
+	executionOutput.Nested = c.pExecutionInputNestedToPExecutionOutputNested(source.Nested)
+	return executionOutput
+}
+func (c *ConverterImpl) pExecutionInputNestedToPExecutionOutputNested(source *execution.InputNested) *execution.OutputNested {
+	var pExecutionOutputNested *execution.OutputNested
+	if source != nil {
+		var executionOutputNested execution.OutputNested
+		executionOutputNested.Age = (*source).Age
+		pExecutionOutputNested = &executionOutputNested
+	}
+	return pExecutionOutputNested
+}
@@ -0,0 +1,36 @@
+package execution
+
+// goverter:converter
+// goverter:ignoreMissing
+// goverter:ignoreUnexported
+// goverter:extend ParseAge
+type Converter interface {
+    // goverter:map Nested.Name NestedName
+    // goverter:map Age | ParseAge
+    // goverter:ignore Internal
+    // goverter:default:value Currency "EUR"
+    // goverter:required ID
+    Convert(source Input) Output
+}
+
+func ParseAge(age string) int {
+    return len(age)
+}
+
+type Input struct {
+    ID     int
+    Age    string
+    Nested InputNested
+}
+type InputNested struct {
+    Name string
+}
+type Output struct {
+    ID         int
+    Age        int
+    NestedName string
+    Currency   string
+    Missing    string
+    Internal   string
+    unexported string
+}
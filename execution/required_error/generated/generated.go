@@ -0,0 +1,29 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import (
+	"errors"
+	execution "github.com/jmattheis/goverter/execution"
+)
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source execution.Input) (execution.Output, error) {
+	var executionOutput execution.Output
+	executionOutput.ID = source.ID
+	if source.Owner != nil {
+		xstring := *source.Owner
+		executionOutput.Owner = &xstring
+	}
+	executionOutput.Name = source.Name
+	if executionOutput.ID == "" {
+		return executionOutput, errors.New("required field ID is zero")
+	}
+	if executionOutput.Owner == nil {
+		return executionOutput, errors.New("required field Owner is zero")
+	}
+	return executionOutput, nil
+}
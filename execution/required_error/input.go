@@ -0,0 +1,18 @@
+package execution
+
+// goverter:converter
+type Converter interface {
+    // goverter:required ID Owner
+    Convert(source Input) (Output, error)
+}
+
+type Input struct {
+    ID    string
+    Owner *string
+    Name  string
+}
+type Output struct {
+    ID    string
+    Owner *string
+    Name  string
+}
@@ -0,0 +1,14 @@
+package execution
+
+// goverter:converter
+type Converter interface {
+    // goverter:required Missing
+    Convert(source Input) (Output, error)
+}
+
+type Input struct {
+    ID int
+}
+type Output struct {
+    ID int
+}
@@ -0,0 +1,17 @@
+package execution
+
+// goverter:converter
+type Converter interface {
+    // goverter:required Nested
+    Convert(source Input) (Output, error)
+}
+
+type Input struct {
+    Nested Nested
+}
+type Nested struct {
+    Values []int
+}
+type Output struct {
+    Nested Nested
+}
@@ -0,0 +1,16 @@
+package execution
+
+// goverter:converter
+type Converter interface {
+    // goverter:required ID
+    Convert(source Input) Output
+}
+
+type Input struct {
+    ID   int
+    Name string
+}
+type Output struct {
+    ID   int
+    Name string
+}
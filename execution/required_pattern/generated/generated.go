@@ -0,0 +1,44 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import (
+	"errors"
+	"fmt"
+	execution "github.com/jmattheis/goverter/execution"
+)
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source execution.Input) (execution.Output, error) {
+	var executionOutput execution.Output
+	executionOutput.ID = source.ID
+	if source.Items != nil {
+		executionOutput.Items = make([]execution.OutputItem, len(source.Items))
+		for i := 0; i < len(source.Items); i++ {
+			executionOutput.Items[i].ID = source.Items[i].ID
+			executionOutput.Items[i].Name = source.Items[i].Name
+			if executionOutput.Items[i].ID == 0 {
+				return executionOutput, fmt.Errorf("required field Items[%d].ID is zero", i)
+			}
+		}
+	}
+	if source.Meta != nil {
+		executionOutput.Meta = make(map[string]execution.OutputItem, len(source.Meta))
+		for key, value := range source.Meta {
+			var executionOutputItem execution.OutputItem
+			executionOutputItem.ID = value.ID
+			executionOutputItem.Name = value.Name
+			if executionOutputItem.ID == 0 {
+				return executionOutput, fmt.Errorf("required field Meta[%v].ID is zero", key)
+			}
+			executionOutput.Meta[key] = executionOutputItem
+		}
+	}
+	if executionOutput.ID == 0 {
+		return executionOutput, errors.New("required field ID is zero")
+	}
+	return executionOutput, nil
+}
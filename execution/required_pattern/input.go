@@ -0,0 +1,26 @@
+package execution
+
+// goverter:converter
+// goverter:requireNonZero ^ID$
+type Converter interface {
+    Convert(source Input) (Output, error)
+}
+
+type Input struct {
+    ID    int
+    Items []Item
+    Meta  map[string]Item
+}
+type Item struct {
+    ID   int
+    Name string
+}
+type Output struct {
+    ID    int
+    Items []OutputItem
+    Meta  map[string]OutputItem
+}
+type OutputItem struct {
+    ID   int
+    Name string
+}
@@ -0,0 +1,26 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import (
+	"errors"
+	execution "github.com/jmattheis/goverter/execution"
+)
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source execution.Input) (execution.Output, error) {
+	var executionOutput execution.Output
+	if source.Owner != nil {
+		var executionOutputOwner execution.OutputOwner
+		executionOutputOwner.Name = (*source.Owner).Name
+		executionOutputOwner.Group.ID = (*source.Owner).Group.ID
+		if executionOutputOwner.Group.ID == 0 {
+			return executionOutput, errors.New("required field Owner.Group.ID is zero")
+		}
+		executionOutput.Owner = &executionOutputOwner
+	}
+	return executionOutput, nil
+}
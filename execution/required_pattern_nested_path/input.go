@@ -0,0 +1,28 @@
+package execution
+
+// goverter:converter
+// goverter:requireNonZero ^ID$
+type Converter interface {
+    Convert(source Input) (Output, error)
+}
+
+type Input struct {
+    Owner *Owner
+}
+type Owner struct {
+    Name  string
+    Group Group
+}
+type Group struct {
+    ID int
+}
+type Output struct {
+    Owner *OutputOwner
+}
+type OutputOwner struct {
+    Name  string
+    Group OutputGroup
+}
+type OutputGroup struct {
+    ID int
+}
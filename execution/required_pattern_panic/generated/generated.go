@@ -0,0 +1,27 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	execution "github.com/jmattheis/goverter/execution"
+)
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source []execution.Item) []execution.OutputItem {
+	var executionOutputItemList []execution.OutputItem
+	if source != nil {
+		executionOutputItemList = make([]execution.OutputItem, len(source))
+		for i := 0; i < len(source); i++ {
+			executionOutputItemList[i].ID = source[i].ID
+			executionOutputItemList[i].Name = source[i].Name
+			if executionOutputItemList[i].ID == 0 {
+				panic(fmt.Sprintf("required field [%d].ID is zero", i))
+			}
+		}
+	}
+	return executionOutputItemList
+}
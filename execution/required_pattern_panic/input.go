@@ -0,0 +1,16 @@
+package execution
+
+// goverter:converter
+// goverter:requireNonZero ^ID$
+type Converter interface {
+    Convert(source []Item) []OutputItem
+}
+
+type Item struct {
+    ID   int
+    Name string
+}
+type OutputItem struct {
+    ID   int
+    Name string
+}
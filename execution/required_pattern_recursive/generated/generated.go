@@ -0,0 +1,31 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import (
+	"errors"
+	execution "github.com/jmattheis/goverter/execution"
+)
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source execution.Node) (execution.OutputNode, error) {
+	var executionOutputNode execution.OutputNode
+	executionOutputNode.ID = source.ID
+	if source.Children != nil {
+		executionOutputNode.Children = make([]execution.OutputNode, len(source.Children))
+		for i := 0; i < len(source.Children); i++ {
+			executionOutputNode2, err := c.Convert(source.Children[i])
+			if err != nil {
+				return executionOutputNode, err
+			}
+			executionOutputNode.Children[i] = executionOutputNode2
+		}
+	}
+	if executionOutputNode.ID == 0 {
+		return executionOutputNode, errors.New("required field ID is zero")
+	}
+	return executionOutputNode, nil
+}
@@ -0,0 +1,16 @@
+package execution
+
+// goverter:converter
+// goverter:requireNonZero ^ID$
+type Converter interface {
+    Convert(source Node) (OutputNode, error)
+}
+
+type Node struct {
+    ID       int
+    Children []Node
+}
+type OutputNode struct {
+    ID       int
+    Children []OutputNode
+}
@@ -0,0 +1,39 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import (
+	"errors"
+	"fmt"
+	execution "github.com/jmattheis/goverter/execution"
+)
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source execution.Input) (execution.Output, error) {
+	var executionOutput execution.Output
+	if source.Items != nil {
+		executionOutput.Items = make([]execution.OItem, len(source.Items))
+		for i := 0; i < len(source.Items); i++ {
+			executionOutput.Items[i].ID = source.Items[i].ID
+			if executionOutput.Items[i].ID == 0 {
+				return executionOutput, fmt.Errorf("required field Items[%d].ID is zero", i)
+			}
+		}
+	}
+	executionOutput.Child.ID = source.Child.ID
+	if executionOutput.Child.ID == 0 {
+		return executionOutput, errors.New("required field Child.ID is zero")
+	}
+	return executionOutput, nil
+}
+func (c *ConverterImpl) ConvertPanic(source execution.PanicInput) execution.PanicOutput {
+	var executionPanicOutput execution.PanicOutput
+	executionPanicOutput.Child.ID = source.Child.ID
+	if executionPanicOutput.Child.ID == 0 {
+		panic("required field Child.ID is zero")
+	}
+	return executionPanicOutput
+}
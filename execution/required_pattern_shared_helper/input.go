@@ -0,0 +1,29 @@
+package execution
+
+// goverter:converter
+// goverter:requireNonZero ^ID$
+type Converter interface {
+    ConvertPanic(source PanicInput) PanicOutput
+    Convert(source Input) (Output, error)
+}
+
+type Input struct {
+    Items []Item
+    Child Item
+}
+type PanicInput struct {
+    Child Item
+}
+type PanicOutput struct {
+    Child OItem
+}
+type Item struct {
+    ID int
+}
+type Output struct {
+    Items []OItem
+    Child OItem
+}
+type OItem struct {
+    ID int
+}
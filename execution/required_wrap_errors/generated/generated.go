@@ -0,0 +1,30 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import (
+	"errors"
+	execution "github.com/jmattheis/goverter/execution"
+)
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source execution.Input) (execution.Output, error) {
+	var executionOutput execution.Output
+	executionOutput.Nested = c.pExecutionNestedToPExecutionNested(source.Nested)
+	if executionOutput.Nested == nil {
+		return executionOutput, errors.New("required field Nested is zero")
+	}
+	return executionOutput, nil
+}
+func (c *ConverterImpl) pExecutionNestedToPExecutionNested(source *execution.Nested) *execution.Nested {
+	var pExecutionNested *execution.Nested
+	if source != nil {
+		var executionNested execution.Nested
+		executionNested.ID = (*source).ID
+		pExecutionNested = &executionNested
+	}
+	return pExecutionNested
+}
@@ -0,0 +1,23 @@
+package execution
+
+// goverter:converter
+// goverter:wrapErrors
+// goverter:requireNonZero ^ID$
+type Converter interface {
+    Convert(source Input) (Output, error)
+}
+
+type Input struct {
+    Items []Item
+    Child Item
+}
+type Item struct {
+    ID int
+}
+type Output struct {
+    Items []OItem
+    Child OItem
+}
+type OItem struct {
+    ID int
+}
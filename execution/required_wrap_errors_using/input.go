@@ -0,0 +1,18 @@
+package execution
+
+// goverter:converter
+// goverter:wrapErrorsUsing github.com/jmattheis/goverter/execution/patherr
+type Converter interface {
+    // goverter:required Nested
+    Convert(source Input) (Output, error)
+}
+
+type Input struct {
+    Nested *Nested
+}
+type Nested struct {
+    ID int
+}
+type Output struct {
+    Nested *Nested
+}
@@ -0,0 +1,6 @@
+package slices
+
+// goverter:converter
+type Converter interface {
+    Convert(source string) (string, error)
+}
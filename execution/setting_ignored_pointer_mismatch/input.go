@@ -0,0 +1,22 @@
+package structs
+
+// goverter:converter
+// goverter:useZeroValueOnPointerInconsistency
+type Converter interface {
+    Convert2(Input2) Output2
+
+    // goverter:map FullName Name
+    Convert(source *Input) Output
+}
+type Input struct {
+    FullName string
+}
+type Input2 struct {
+    Value *Input
+}
+type Output2 struct {
+    Value *Output
+}
+type Output struct {
+    Name string
+}
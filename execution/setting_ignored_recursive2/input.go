@@ -0,0 +1,14 @@
+package structs
+// goverter:converter
+type Converter interface {
+    // goverter:map Inputs Outputs
+    Convert(source Input) *Output
+}
+type Input struct {
+    Name string
+    Inputs []Input
+}
+type Output struct {
+    Name string
+    Outputs []Output
+}
@@ -0,0 +1,17 @@
+package structs
+
+// goverter:converter
+// goverter:useZeroValueOnPointerInconsistency
+type Converter interface {
+    // goverter:map Inputs Outputs
+    // goverter:ignore Help
+    Convert(source *Input) Output
+}
+type Input struct {
+    Name string
+    Inputs []Input
+}
+type Output struct {
+    Name string
+    Outputs []Output
+}
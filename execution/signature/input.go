@@ -0,0 +1,6 @@
+package primitive
+
+// goverter:converter
+type Converter interface {
+    ConvertString(source func() string) func() string
+}
@@ -0,0 +1,11 @@
+package singleton
+
+// goverter:converter
+// goverter:output:singleton:name Default
+// goverter:output:file ./generated.go
+// goverter:output:package :singleton
+type Converter interface {
+    Convert(source string) string
+}
+
+func Default() {}
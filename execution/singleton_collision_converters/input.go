@@ -0,0 +1,12 @@
+package singleton
+
+// goverter:converter
+type A interface {
+    Convert(source string) string
+}
+
+// goverter:converter
+// goverter:name AConvert
+type B interface {
+    Convert(source int) int
+}
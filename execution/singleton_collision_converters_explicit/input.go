@@ -0,0 +1,13 @@
+package singleton
+
+// goverter:converter
+// goverter:output:singleton:name Shared
+type A interface {
+    Convert(source string) string
+}
+
+// goverter:converter
+// goverter:output:singleton:name Shared
+type B interface {
+    Convert(source int) int
+}
@@ -0,0 +1,10 @@
+package singleton
+
+// goverter:converter
+// goverter:output:file ./generated.go
+// goverter:output:package :singleton
+type Converter interface {
+    Convert(source string) string
+}
+
+func ConverterConvert() {}
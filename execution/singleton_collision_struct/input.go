@@ -0,0 +1,7 @@
+package singleton
+
+// goverter:converter
+// goverter:output:singleton:name ConverterImpl
+type Converter interface {
+    Convert(source string) string
+}
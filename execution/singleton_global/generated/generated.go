@@ -0,0 +1,17 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+type ConverterImpl struct{}
+
+func (c *ConverterImpl) Convert(source string) string {
+	return source
+}
+
+type OtherImpl struct{}
+
+var OtherConvert = OtherImpl{}
+
+func (c *OtherImpl) Convert(source int) int {
+	return source
+}
@@ -0,0 +1,12 @@
+package singleton
+
+// goverter:converter
+type Converter interface {
+    Convert(source string) string
+}
+
+// goverter:converter
+// goverter:output:singleton yes
+type Other interface {
+    Convert(source int) int
+}
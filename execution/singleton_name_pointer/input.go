@@ -0,0 +1,8 @@
+package singleton
+
+// goverter:converter
+// goverter:output:singleton:name Instance
+// goverter:output:singleton:pointer
+type Converter interface {
+    Convert(source string) string
+}
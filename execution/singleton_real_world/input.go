@@ -0,0 +1,33 @@
+package example
+
+// goverter:converter
+type UserService interface {
+    CreateUser(source CreateUserRequest) User
+}
+
+// goverter:converter  
+type OrderConverterImpl interface {
+    ConvertOrder(source OrderDTO) Order
+}
+
+type CreateUserRequest struct {
+    Name  string
+    Email string
+}
+
+type User struct {
+    Name  string
+    Email string
+}
+
+type OrderDTO struct {
+    ID       int
+    UserID   int
+    Amount   float64
+}
+
+type Order struct {
+    ID       int
+    UserID   int
+    Amount   float64
+}
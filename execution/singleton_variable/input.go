@@ -0,0 +1,6 @@
+package singleton
+
+// goverter:converter
+type ConverterImpl interface {
+    Convert(source string) string
+}
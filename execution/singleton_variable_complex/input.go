@@ -0,0 +1,27 @@
+package singleton
+
+// goverter:converter
+type UserConverterImpl interface {
+    ConvertUser(source User) UserDTO
+}
+
+// goverter:converter
+type ProductConverter interface {
+    ConvertProduct(source Product) ProductDTO
+}
+
+type User struct {
+    Name string
+}
+
+type UserDTO struct {
+    Name string
+}
+
+type Product struct {
+    Title string
+}
+
+type ProductDTO struct {
+    Title string
+}
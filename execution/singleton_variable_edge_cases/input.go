@@ -0,0 +1,11 @@
+package singleton
+
+// goverter:converter
+type SimpleConverter interface {
+    Convert(source string) string
+}
+
+// goverter:converter
+type MyServiceImpl interface {
+    Process(source int) int
+}
@@ -0,0 +1,7 @@
+package singleton
+
+// goverter:variables
+// goverter:output:singleton no
+var (
+    Convert func(source string) string
+)
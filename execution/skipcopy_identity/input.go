@@ -0,0 +1,7 @@
+package skip
+
+// goverter:converter
+// goverter:skipCopySameType
+type Converter interface {
+    Convert(source map[string]int) map[string]int
+}
@@ -0,0 +1,34 @@
+package skip
+
+// goverter:converter
+type UsesGlobal interface {
+    Enabled(*string) *string
+}
+
+// goverter:converter
+// goverter:skipCopySameType yes
+type KeepsGlobalValue interface {
+    Enabled(*string) *string
+}
+
+
+// goverter:converter
+// goverter:skipCopySameType no
+type DisablesGlobal interface {
+    Disabled(*int) *int
+}
+
+// goverter:converter
+// goverter:skipCopySameType no
+type EnableInSubMethod interface {
+    Disable(*string) *string
+    // goverter:skipCopySameType
+    Enabled(*int) *int
+}
+
+// goverter:converter
+type DisableInSubMethod interface {
+    Enabled(*string) *string
+    // goverter:skipCopySameType no
+    Disable(*int) *int
+}
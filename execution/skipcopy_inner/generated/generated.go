@@ -0,0 +1,30 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import (
+	execution "github.com/jmattheis/goverter/execution"
+	"time"
+)
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source execution.Input) execution.Output {
+	var skipOutput execution.Output
+	skipOutput.ID = source.ID
+	skipOutput.Map = source.Map
+	if source.MapDifferentType != nil {
+		skipOutput.MapDifferentType = make(map[string]execution.ID, len(source.MapDifferentType))
+		for key, value := range source.MapDifferentType {
+			skipOutput.MapDifferentType[key] = execution.ID(value)
+		}
+	}
+	skipOutput.CreatedAt = c.timeTimeToPTimeTime(source.CreatedAt)
+	skipOutput.Unnamed = source.Unnamed
+	return skipOutput
+}
+func (c *ConverterImpl) timeTimeToPTimeTime(source time.Time) *time.Time {
+	return &source
+}
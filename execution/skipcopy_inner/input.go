@@ -0,0 +1,26 @@
+package skip
+
+import "time"
+
+// goverter:converter
+// goverter:skipCopySameType
+type Converter interface {
+    Convert(source Input) Output
+}
+
+type Input struct {
+    ID *int
+    Map map[string]int
+    MapDifferentType map[string]int
+    CreatedAt time.Time
+    Unnamed struct{Name string}
+}
+type Output struct {
+    ID *int
+    Map map[string]int
+    MapDifferentType map[string]ID
+    CreatedAt *time.Time
+    Unnamed struct{Name string}
+}
+
+type ID int
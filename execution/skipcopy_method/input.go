@@ -0,0 +1,14 @@
+package skip
+
+// goverter:converter
+type Converter interface {
+    // goverter:skipCopySameType
+    Convert(source Input) Output
+}
+
+type Input struct {
+    ID *int
+}
+type Output struct {
+    ID *int
+}
@@ -0,0 +1,6 @@
+package slices_arrays
+
+// goverter:converter
+type Converter interface {
+    Convert(source [5]int) []int
+}
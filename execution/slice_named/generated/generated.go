@@ -0,0 +1,80 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import execution "github.com/jmattheis/goverter/execution"
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source []execution.ID) []int {
+	var intList []int
+	if source != nil {
+		intList = make([]int, len(source))
+		for i := 0; i < len(source); i++ {
+			intList[i] = int(source[i])
+		}
+	}
+	return intList
+}
+func (c *ConverterImpl) Convert2(source execution.IDs) []int {
+	var intList []int
+	if source != nil {
+		intList = make([]int, len(source))
+		for i := 0; i < len(source); i++ {
+			intList[i] = source[i]
+		}
+	}
+	return intList
+}
+func (c *ConverterImpl) Convert3(source execution.IDs) execution.IDs {
+	var slicesIDs execution.IDs
+	if source != nil {
+		slicesIDs = make(execution.IDs, len(source))
+		for i := 0; i < len(source); i++ {
+			slicesIDs[i] = source[i]
+		}
+	}
+	return slicesIDs
+}
+func (c *ConverterImpl) Convert4(source []execution.ID) execution.IDs {
+	var slicesIDs execution.IDs
+	if source != nil {
+		slicesIDs = make(execution.IDs, len(source))
+		for i := 0; i < len(source); i++ {
+			slicesIDs[i] = int(source[i])
+		}
+	}
+	return slicesIDs
+}
+func (c *ConverterImpl) Convert5(source execution.IIDs) execution.IDs {
+	var slicesIDs execution.IDs
+	if source != nil {
+		slicesIDs = make(execution.IDs, len(source))
+		for i := 0; i < len(source); i++ {
+			slicesIDs[i] = int(source[i])
+		}
+	}
+	return slicesIDs
+}
+func (c *ConverterImpl) Convert6(source []int) execution.IIDs {
+	var slicesIIDs execution.IIDs
+	if source != nil {
+		slicesIIDs = make(execution.IIDs, len(source))
+		for i := 0; i < len(source); i++ {
+			slicesIIDs[i] = execution.ID(source[i])
+		}
+	}
+	return slicesIIDs
+}
+func (c *ConverterImpl) Convert7(source []int) execution.IDs {
+	var slicesIDs execution.IDs
+	if source != nil {
+		slicesIDs = make(execution.IDs, len(source))
+		for i := 0; i < len(source); i++ {
+			slicesIDs[i] = source[i]
+		}
+	}
+	return slicesIDs
+}
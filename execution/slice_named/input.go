@@ -0,0 +1,15 @@
+package slices
+
+// goverter:converter
+type Converter interface {
+    Convert(source []ID) []int
+    Convert2(source IDs) []int
+    Convert3(source IDs) IDs
+    Convert4(source []ID) IDs
+    Convert5(source IIDs) IDs
+    Convert6(source []int) IIDs
+    Convert7(source []int) IDs
+}
+type ID int
+type IDs []int
+type IIDs []ID
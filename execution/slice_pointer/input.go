@@ -0,0 +1,14 @@
+package slices_arrays
+
+// goverter:converter
+type Converter interface {
+   ConvertHouse(source DBHouseNames) APIHouseNames
+}
+
+type DBHouseNames struct {
+   Names *[]string
+}
+
+type APIHouseNames struct {
+   Names *[]string
+}
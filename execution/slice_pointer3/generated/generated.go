@@ -0,0 +1,32 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import execution "github.com/jmattheis/goverter/execution"
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) ConvertHouse(source execution.DBHouseNames) execution.APIHouseNames {
+	var slices_arraysAPIHouseNames execution.APIHouseNames
+	if source.Names != nil {
+		var pPStringList **[]string
+		if (*source.Names) != nil {
+			var pStringList *[]string
+			if (*(*source.Names)) != nil {
+				var stringList []string
+				if (*(*(*source.Names))) != nil {
+					stringList = make([]string, len((*(*(*source.Names)))))
+					for i := 0; i < len((*(*(*source.Names)))); i++ {
+						stringList[i] = (*(*(*source.Names)))[i]
+					}
+				}
+				pStringList = &stringList
+			}
+			pPStringList = &pStringList
+		}
+		slices_arraysAPIHouseNames.Names = &pPStringList
+	}
+	return slices_arraysAPIHouseNames
+}
@@ -0,0 +1,14 @@
+package slices_arrays
+
+// goverter:converter
+type Converter interface {
+   ConvertHouse(source []*Input) []*Output
+}
+
+type Input struct {
+   Name string
+}
+
+type Output struct {
+   Name string
+}
@@ -0,0 +1,7 @@
+package slices
+
+// goverter:converter
+// goverter:extend strconv:Atoi
+type Converter interface {
+    Convert(source []string) ([]int, error)
+}
@@ -0,0 +1,8 @@
+package slices
+
+// goverter:converter
+// goverter:extend strconv:Atoi
+type Converter interface {
+    // goverter:wrapErrors
+    Convert(source []string) ([]int, error)
+}
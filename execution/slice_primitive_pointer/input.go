@@ -0,0 +1,7 @@
+package slices
+
+// goverter:converter
+type Converter interface {
+    ConvertToP(source []int) []*int
+    ConvertPToP(source []*int) []*int
+}
@@ -0,0 +1,26 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import execution "github.com/jmattheis/goverter/execution"
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source []execution.Input) []execution.Output {
+	var slicesOutputList []execution.Output
+	if source != nil {
+		slicesOutputList = make([]execution.Output, len(source))
+		for i := 0; i < len(source); i++ {
+			slicesOutputList[i] = c.slicesInputToSlicesOutput(source[i])
+		}
+	}
+	return slicesOutputList
+}
+func (c *ConverterImpl) slicesInputToSlicesOutput(source execution.Input) execution.Output {
+	var slicesOutput execution.Output
+	slicesOutput.Name = source.Name
+	slicesOutput.Age = source.Age
+	return slicesOutput
+}
@@ -0,0 +1,46 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import execution "github.com/jmattheis/goverter/execution"
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) ConvertP(source []execution.Input) []*execution.Output {
+	var pSlicesOutputList []*execution.Output
+	if source != nil {
+		pSlicesOutputList = make([]*execution.Output, len(source))
+		for i := 0; i < len(source); i++ {
+			pSlicesOutputList[i] = c.slicesInputToPSlicesOutput(source[i])
+		}
+	}
+	return pSlicesOutputList
+}
+func (c *ConverterImpl) ConvertPToP(source []*execution.Input) []*execution.Output {
+	var pSlicesOutputList []*execution.Output
+	if source != nil {
+		pSlicesOutputList = make([]*execution.Output, len(source))
+		for i := 0; i < len(source); i++ {
+			pSlicesOutputList[i] = c.pSlicesInputToPSlicesOutput(source[i])
+		}
+	}
+	return pSlicesOutputList
+}
+func (c *ConverterImpl) pSlicesInputToPSlicesOutput(source *execution.Input) *execution.Output {
+	var pSlicesOutput *execution.Output
+	if source != nil {
+		var slicesOutput execution.Output
+		slicesOutput.Name = (*source).Name
+		slicesOutput.Age = (*source).Age
+		pSlicesOutput = &slicesOutput
+	}
+	return pSlicesOutput
+}
+func (c *ConverterImpl) slicesInputToPSlicesOutput(source execution.Input) *execution.Output {
+	var slicesOutput execution.Output
+	slicesOutput.Name = source.Name
+	slicesOutput.Age = source.Age
+	return &slicesOutput
+}
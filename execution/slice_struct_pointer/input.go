@@ -0,0 +1,16 @@
+package slices
+
+// goverter:converter
+type Converter interface {
+    ConvertP(source []Input) []*Output
+    ConvertPToP(source []*Input) []*Output
+}
+
+type Input struct {
+    Name string
+    Age int
+}
+type Output struct {
+    Name string
+    Age int
+}
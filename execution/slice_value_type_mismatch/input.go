@@ -0,0 +1,6 @@
+package primitive
+
+// goverter:converter
+type Converter interface {
+    Convert(source []int16) []int8
+}
@@ -0,0 +1,16 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    Convert(source Input) Output
+}
+
+type Input struct {
+    Name string
+    Age int
+    internalState string
+}
+type Output struct {
+    Name string
+    Age int
+}
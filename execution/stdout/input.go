@@ -0,0 +1,13 @@
+package structs
+
+// goverter:converter
+// goverter:output:file ./a/generated.go
+type A interface {
+    Convert(source string) string
+}
+
+// goverter:converter
+// goverter:output:file ./b/generated.go
+type B interface {
+    Convert(source int) int
+}
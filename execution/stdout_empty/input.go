@@ -0,0 +1,5 @@
+package structs
+
+type Input struct {
+    Name string
+}
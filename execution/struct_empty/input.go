@@ -0,0 +1,19 @@
+package structs
+
+// goverter:converter
+// goverter:ignoreUnexported
+type Converter interface {
+    Convert1(source []struct{}) []struct{}
+    Convert2(source map[string]struct{}) map[string]struct{}
+    Convert4(source *struct{}) *struct{}
+    Convert5(source map[string]struct{Name string}) map[string]struct{}
+    Convert6(source map[string]struct{Name string}) map[string]struct{name string}
+    Convert7(source map[struct{}]struct{}) map[struct{}]struct{}
+    Convert8(source map[struct{}]struct{}) map[struct{name string}]struct{}
+    Convert9(source map[struct{}]struct{}) map[struct{name string}]struct{name string}
+}
+
+// goverter:converter
+type Converter2 interface {
+    Convert(source struct{}) struct{}
+}
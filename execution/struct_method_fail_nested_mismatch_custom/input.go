@@ -0,0 +1,31 @@
+package structs
+
+import "time"
+
+// goverter:converter
+type Converter interface {
+    // goverter:map Nested.Inner.Inner2.Name Name | MapName
+    Convert(source Input) Output
+}
+
+func MapName(string) string {
+    return ""
+}
+
+type Input struct {
+    Nested *InputNested
+}
+type InputNested struct {
+    Inner InputNestedNested
+}
+type InputNestedNested struct {
+    Inner2 *InputNestedNestedNested
+}
+type InputNestedNestedNested struct {
+}
+func (InputNestedNestedNested) Name() time.Time {
+    return time.Now()
+}
+type Output struct {
+    Name *string
+}
@@ -0,0 +1,20 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:map Nested.Age Age
+    Convert(source Input) Output
+}
+
+type Input struct {
+    Nested *Nested
+}
+
+type Nested struct {
+}
+func (Nested) Age() int {
+    return 42
+}
+type Output struct {
+    Age *int
+}
@@ -0,0 +1,26 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import execution "github.com/jmattheis/goverter/execution"
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source execution.Input) (execution.Output, error) {
+	var structsOutput execution.Output
+	var pInt *int
+	if source.Nested != nil {
+		xint, err := source.Nested.Age()
+		if err != nil {
+			return structsOutput, err
+		}
+		pInt = &xint
+	}
+	if pInt != nil {
+		xint2 := *pInt
+		structsOutput.Age = &xint2
+	}
+	return structsOutput, nil
+}
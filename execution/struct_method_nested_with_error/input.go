@@ -0,0 +1,20 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:map Nested.Age Age
+    Convert(source Input) (Output, error)
+}
+
+type Input struct {
+    Nested *Nested
+}
+
+type Nested struct {
+}
+func (Nested) Age() (int, error) {
+    return 42, nil
+}
+type Output struct {
+    Age *int
+}
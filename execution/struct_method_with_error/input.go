@@ -0,0 +1,17 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    Convert(source Input) (Output, error)
+}
+
+type Input struct {
+    Name string
+}
+func (Input) Age() (int, error) {
+    return 42, nil
+}
+type Output struct {
+    Name string
+    Age int
+}
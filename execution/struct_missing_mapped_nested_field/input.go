@@ -0,0 +1,19 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:map Nested.Name3 Name
+    Convert(source Input) Output
+}
+
+type Input struct {
+    Nested Nested
+    Name string
+}
+type Nested struct {
+    Name string
+}
+type Output struct {
+    Name string
+    Age int
+}
@@ -0,0 +1,45 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import execution "github.com/jmattheis/goverter/execution"
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source execution.Input) execution.Output {
+	var structsOutput execution.Output
+	if source.X != nil {
+		pStructsX := c.pStructsXToPStructsX((*source.X))
+		structsOutput.X = &pStructsX
+	}
+	return structsOutput
+}
+func (c *ConverterImpl) pStructsXToPStructsX(source *execution.X) *execution.X {
+	var pStructsX *execution.X
+	if source != nil {
+		var structsX execution.X
+		structsX.Y = c.pStructsYToPStructsY((*source).Y)
+		pStructsX = &structsX
+	}
+	return pStructsX
+}
+func (c *ConverterImpl) pStructsYToPStructsY(source *execution.Y) *execution.Y {
+	var pStructsY *execution.Y
+	if source != nil {
+		var structsY execution.Y
+		structsY.Z = c.pStructsZToPStructsZ((*source).Z)
+		pStructsY = &structsY
+	}
+	return pStructsY
+}
+func (c *ConverterImpl) pStructsZToPStructsZ(source *execution.Z) *execution.Z {
+	var pStructsZ *execution.Z
+	if source != nil {
+		var structsZ execution.Z
+		structsZ.Z = (*source).Z
+		pStructsZ = &structsZ
+	}
+	return pStructsZ
+}
@@ -0,0 +1,23 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    Convert(source Input) Output
+}
+
+type X struct {
+    Y *Y
+}
+type Y struct {
+    Z *Z
+}
+type Z struct {
+    Z string
+}
+
+type Input struct {
+    X **X
+}
+type Output struct {
+    X **X
+}
@@ -0,0 +1,16 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:ignore Name2
+    Convert(source *Input) *Output
+}
+
+type Input struct {
+    Name string
+    Age int
+}
+type Output struct {
+    Name2 string
+    Age int
+}
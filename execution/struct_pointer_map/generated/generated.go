@@ -0,0 +1,27 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import execution "github.com/jmattheis/goverter/execution"
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source *execution.InputOne) *execution.OutputOne {
+	var pStructsOutputOne *execution.OutputOne
+	if source != nil {
+		var structsOutputOne execution.OutputOne
+		structsOutputOne.Name2 = (*source).Name
+		structsOutputOne.Age = (*source).Age
+		structsOutputOne.Nested = c.structsInputTwoToStructsOutputTwo((*source).Nested)
+		pStructsOutputOne = &structsOutputOne
+	}
+	return pStructsOutputOne
+}
+func (c *ConverterImpl) structsInputTwoToStructsOutputTwo(source execution.InputTwo) execution.OutputTwo {
+	var structsOutputTwo execution.OutputTwo
+	structsOutputTwo.Name = source.Name
+	structsOutputTwo.Age = source.Age
+	return structsOutputTwo
+}
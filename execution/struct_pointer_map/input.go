@@ -0,0 +1,26 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:map Name Name2
+    Convert(source *InputOne) *OutputOne
+}
+
+type InputOne struct {
+    Name string
+    Age int
+    Nested InputTwo
+}
+type InputTwo struct {
+    Name string
+    Age int
+}
+type OutputOne struct {
+    Name2 string
+    Age int
+    Nested OutputTwo
+}
+type OutputTwo struct {
+    Name string
+    Age int
+}
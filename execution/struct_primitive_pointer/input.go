@@ -0,0 +1,19 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    Convert(source Input) Output
+}
+
+type Input struct {
+    ID string
+    Name string
+    Age *int
+}
+type Output struct {
+    ID *ID
+    Name *string
+    Age *int
+}
+
+type ID string
@@ -0,0 +1,13 @@
+package slices_arrays
+
+// goverter:converter
+type Converter interface {
+    Convert(Input) Output
+}
+
+type Input struct {
+    Value [5]int
+}
+type Output struct {
+    Value []int
+}
@@ -0,0 +1,23 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    ConvertHouse(source Input) Output
+}
+
+type X struct { X string }
+type Y struct { Y string }
+
+type Input struct {
+    House struct {
+        X
+        Y
+    }
+}
+
+type Output struct {
+    House struct {
+        X
+        Y `json:"abc"`
+    }
+}
@@ -0,0 +1,24 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import (
+	"database/sql"
+	execution "github.com/jmattheis/goverter/execution"
+)
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) ConvertHouse(source execution.Input) execution.Output {
+	var structsOutput execution.Output
+	structsOutput.House.Name = c.sqlNullStringToSqlNullString(source.House.Name)
+	return structsOutput
+}
+func (c *ConverterImpl) sqlNullStringToSqlNullString(source sql.NullString) sql.NullString {
+	var sqlNullString sql.NullString
+	sqlNullString.String = source.String
+	sqlNullString.Valid = source.Valid
+	return sqlNullString
+}
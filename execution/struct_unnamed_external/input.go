@@ -0,0 +1,22 @@
+package structs
+
+import (
+    "database/sql"
+)
+
+// goverter:converter
+type Converter interface {
+    ConvertHouse(source Input) Output
+}
+
+type Input struct {
+    House struct {
+        Name sql.NullString
+    }
+}
+
+type Output struct {
+    House struct {
+        Name sql.NullString
+    }
+}
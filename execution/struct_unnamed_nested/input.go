@@ -0,0 +1,20 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    ConvertHouse(source Input) Output
+}
+
+type Input struct {
+    House struct {
+        Name string
+        Inner struct {Z string}
+    }
+}
+
+type Output struct {
+    House struct {
+        Name string
+        Inner struct {Z string `json:"y"`}
+    }
+}
@@ -0,0 +1,22 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    ConvertHouse(source Input) Output
+}
+
+type Input struct {
+    House *struct {
+        Inner *struct {
+            Name string
+        }
+    }
+}
+
+type Output struct {
+    House *struct {
+        Inner *struct {
+            Name string
+        }
+    }
+}
@@ -0,0 +1,34 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import execution "github.com/jmattheis/goverter/execution"
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) ConvertHouse(source execution.Input) execution.Output {
+	var structsOutput execution.Output
+	if source.House != nil {
+		structsOutput.House = make([][]struct {
+			Names []string
+		}, len(source.House))
+		for i := 0; i < len(source.House); i++ {
+			if source.House[i] != nil {
+				structsOutput.House[i] = make([]struct {
+					Names []string
+				}, len(source.House[i]))
+				for j := 0; j < len(source.House[i]); j++ {
+					if source.House[i][j].Names != nil {
+						structsOutput.House[i][j].Names = make([]string, len(source.House[i][j].Names))
+						for k := 0; k < len(source.House[i][j].Names); k++ {
+							structsOutput.House[i][j].Names[k] = source.House[i][j].Names[k]
+						}
+					}
+				}
+			}
+		}
+	}
+	return structsOutput
+}
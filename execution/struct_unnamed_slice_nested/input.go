@@ -0,0 +1,18 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    ConvertHouse(source Input) Output
+}
+
+type Input struct {
+    House [][]struct {
+        Names []string
+    }
+}
+
+type Output struct {
+    House [][]struct {
+        Names []string
+    }
+}
@@ -0,0 +1,11 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:autoMap Adress
+    Convert(source Input) Output
+}
+
+type Input struct { Address Address }
+type Address struct { Street string }
+type Output struct { Street string }
@@ -0,0 +1,10 @@
+package structs
+
+// goverter:converter
+// goverter:output:fiel ./generated/generated.go
+type Converter interface {
+    Convert(source Input) Output
+}
+
+type Input struct { Name string }
+type Output struct { Name string }
@@ -0,0 +1,10 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:ignoremissing
+    Convert(source Input) Output
+}
+
+type Input struct { Name string }
+type Output struct { Name string }
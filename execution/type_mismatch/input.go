@@ -0,0 +1,32 @@
+package err
+
+// goverter:converter
+type Converter interface {
+    // goverter:map First FirstAABBCC
+    Convert(source Input) Output
+}
+
+type Input struct {
+    First Input2
+}
+type Input2 struct {
+    Second []Input3
+}
+type Input3 struct {
+    Third map[string]Input4
+}
+type Input4 struct {
+    Value string
+}
+type Output struct {
+    FirstAABBCC Output2
+}
+type Output2 struct {
+    Second []Output3
+}
+type Output3 struct {
+    Third map[string]Output4
+}
+type Output4 struct {
+    Value int
+}
@@ -0,0 +1,15 @@
+package execution
+
+// goverter:converter
+// goverter:output:file ./generated.go
+// goverter:output:package github.com/jmattheis/goverter/execution
+type Converter interface {
+    convert(source Input) Output
+}
+
+type Input struct {
+    Age int
+}
+type Output struct {
+    Age int
+}
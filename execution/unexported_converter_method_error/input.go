@@ -0,0 +1,13 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    convert(source Input) Output
+}
+
+type Input struct {
+    Age int
+}
+type Output struct {
+    Age int
+}
@@ -0,0 +1,16 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    Convert(source Input) Output
+}
+
+type Input struct {
+    name string
+    Age int
+    ignored string
+}
+type Output struct {
+    name string
+    Age int
+}
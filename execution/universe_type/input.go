@@ -0,0 +1,10 @@
+package universe
+
+// goverter:converter
+// goverter:extend Error
+type Converter interface {
+    Error(source *error) *error
+}
+func Error(value error) error {
+    return value
+}
@@ -0,0 +1,14 @@
+package universe
+
+// goverter:converter
+type Converter interface {
+    AToB(A) B
+}
+
+type A struct {
+    Property int
+}
+
+type B struct {
+    Property error
+}
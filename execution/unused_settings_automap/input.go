@@ -0,0 +1,16 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:autoMap Address
+    // goverter:autoMap Person
+    Convert(source Input) Output
+}
+
+type Input struct {
+    Address Address
+    Person Person
+}
+type Address struct { Street string }
+type Person struct { Age int }
+type Output struct { Street string }
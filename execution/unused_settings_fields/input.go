@@ -0,0 +1,19 @@
+package structs
+
+// goverter:converter
+// goverter:extend ConvertInner
+type Converter interface {
+    // goverter:map Name FullName
+    // goverter:ignore Age
+    Convert(source Input) Output
+}
+
+func ConvertInner(source Input) Output {
+    return Output{FullName: source.Name}
+}
+
+type Input struct { Name string }
+type Output struct {
+    FullName string
+    Age int
+}
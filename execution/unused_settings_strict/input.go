@@ -0,0 +1,12 @@
+package structs
+
+// goverter:converter
+// goverter:extend IntToString
+type Converter interface {
+    Convert(source Input) Output
+}
+
+func IntToString(value int) string { return "" }
+
+type Input struct { Name string }
+type Output struct { Name string }
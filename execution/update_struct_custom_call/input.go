@@ -0,0 +1,15 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:update target
+    // goverter:map A | strconv:Itoa
+    Convert(source Input, target *Output) error
+}
+
+type Input struct {
+    A int
+}
+type Output struct {
+    A string
+}
@@ -0,0 +1,15 @@
+package structs
+
+// goverter:converter
+// goverter:extend strconv:Atoi
+type Converter interface {
+    // goverter:update target
+    Convert(source Input, target *Output)
+}
+
+type Input struct {
+    A *string
+}
+type Output struct {
+    A *int
+}
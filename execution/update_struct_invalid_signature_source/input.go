@@ -0,0 +1,11 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:update target
+    Convert(source string, target *Target) error
+}
+
+type Target struct {
+    A string
+}
@@ -0,0 +1,17 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:update abc
+    Convert(source Input, target *Target) error
+}
+
+type Target struct {
+    A string
+}
+type Input struct {
+    A string
+}
+type Output struct {
+    A string
+}
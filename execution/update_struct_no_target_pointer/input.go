@@ -0,0 +1,14 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:update target
+    Convert(source Input, target Target)
+}
+
+type Target struct {
+    A string
+}
+type Input struct {
+    A string
+}
@@ -0,0 +1,14 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:update target
+    Convert(source *Input, target *Output)
+}
+
+type Input struct {
+    A string
+}
+type Output struct {
+    A string
+}
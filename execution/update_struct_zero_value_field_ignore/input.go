@@ -0,0 +1,52 @@
+package structs
+
+// goverter:converter
+type Default interface {
+    // goverter:update target
+    Convert(source Input, target *Output)
+}
+
+// goverter:converter
+type IgnoreBasic interface {
+    // goverter:update target
+    // goverter:update:ignoreZeroValueField:basic
+    Convert(source Input, target *Output)
+}
+
+// goverter:converter
+type IgnoreStruct interface {
+    // goverter:update target
+    // goverter:update:ignoreZeroValueField:struct
+    Convert(source Input, target *Output)
+}
+
+type Input struct {
+    A string
+    A2 ID
+    B bool
+    C int
+    C2 int64
+    D complex64
+    E struct{Name string}
+    N Nested
+
+    S *string
+    I *int
+}
+type Output struct {
+    A string
+    A2 ID
+    B bool
+    C int
+    C2 int64
+    D complex64
+    E struct{Name string}
+    N Nested
+
+    S *string
+    I *int
+}
+type ID string
+type Nested struct {
+    Name string
+}
@@ -0,0 +1,25 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import execution "github.com/jmattheis/goverter/execution"
+
+type Converter2Impl struct{}
+
+var Converter2Convert = Converter2Impl{}
+
+func (c *Converter2Impl) Convert(source execution.StringInput, target *execution.StringOutput) {
+	if source.N != "" {
+		target.N = execution.CustomString(source.N)
+	}
+}
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) Convert(source execution.StructInput, target *execution.StructOutput) {
+	if source.N != (execution.StringInput{}) {
+		target.N = execution.CustomStruct(source.N)
+	}
+}
@@ -0,0 +1,26 @@
+package structs
+
+// goverter:converter
+type Converter interface {
+    // goverter:update target
+    // goverter:update:ignoreZeroValueField:struct
+    // goverter:map N | CustomStruct
+    Convert(source StructInput, target *StructOutput)
+}
+
+// goverter:converter
+type Converter2 interface {
+    // goverter:update target
+    // goverter:update:ignoreZeroValueField:basic
+    // goverter:map N | CustomString
+    Convert(source StringInput, target *StringOutput)
+}
+
+func CustomStruct(StringInput) StringInput { return StringInput{} }
+func CustomString(string) string { return "world" }
+
+type StringInput struct { N string }
+type StringOutput struct { N string }
+
+type StructInput struct { N StringInput }
+type StructOutput struct { N StringInput }
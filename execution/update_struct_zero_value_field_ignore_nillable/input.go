@@ -0,0 +1,43 @@
+package structs
+
+import "io"
+
+// goverter:converter
+type IgnoreNil interface {
+    // goverter:update target
+    // goverter:update:ignoreZeroValueField:nillable
+    // goverter:map P1 | Identity
+    // goverter:map P2 | Identity
+    // goverter:map P3 | Identity
+    // goverter:map P4 | Identity
+    // goverter:map P5 | Identity
+    Convert(source Input, target *Output)
+}
+
+type Input struct {
+    A string
+
+    P1 chan string
+    P2 io.Reader
+    P3 map[string]string
+    P4 []string
+    P5 *string
+}
+type Output struct {
+    A string
+
+    P1 chan string
+    P2 io.Reader
+    P3 map[string]string
+    P4 []string
+    P5 *string
+}
+
+func Identity[T any](t T) T {
+    return t
+}
+
+type ID string
+type Nested struct {
+    Name string
+}
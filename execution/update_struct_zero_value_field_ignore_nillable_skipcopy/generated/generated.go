@@ -0,0 +1,28 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import execution "github.com/jmattheis/goverter/execution"
+
+type IgnoreNilImpl struct{}
+
+var IgnoreNilConvert = IgnoreNilImpl{}
+
+func (c *IgnoreNilImpl) Convert(source execution.Input, target *execution.Output) {
+	target.A = source.A
+	if source.P1 != nil {
+		target.P1 = source.P1
+	}
+	if source.P2 != nil {
+		target.P2 = source.P2
+	}
+	if source.P3 != nil {
+		target.P3 = source.P3
+	}
+	if source.P4 != nil {
+		target.P4 = source.P4
+	}
+	if source.P5 != nil {
+		target.P5 = source.P5
+	}
+}
@@ -0,0 +1,22 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) ConvertHouse(source map[string]*string) map[string]string {
+	var mapStringString map[string]string
+	if source != nil {
+		mapStringString = make(map[string]string, len(source))
+		for key, value := range source {
+			var xstring string
+			if value != nil {
+				xstring = *value
+			}
+			mapStringString[key] = xstring
+		}
+	}
+	return mapStringString
+}
@@ -0,0 +1,7 @@
+package slices_arrays
+
+// goverter:converter
+type Converter interface {
+    // goverter:useZeroValueOnPointerInconsistency
+    ConvertHouse(source map[string]*string) map[string]string
+}
@@ -0,0 +1,19 @@
+package structs
+
+// goverter:converter
+// goverter:useZeroValueOnPointerInconsistency
+type Converter interface {
+    // goverter:map Inner.Name Name
+    ConvertPerson(source Person) APIPerson
+}
+
+type Person struct {
+    Inner Inner
+}
+type Inner struct {
+    Name *string
+}
+
+type APIPerson struct {
+    Name string
+}
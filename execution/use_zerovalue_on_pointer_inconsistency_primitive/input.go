@@ -0,0 +1,15 @@
+package structs
+
+// goverter:converter
+// goverter:useZeroValueOnPointerInconsistency
+type Converter interface {
+    ConvertPerson(source Person) APIPerson
+}
+
+type Person struct {
+    FirstName *string
+}
+
+type APIPerson struct {
+    FirstName string
+}
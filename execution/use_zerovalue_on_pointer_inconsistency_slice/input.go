@@ -0,0 +1,15 @@
+package slices_arrays
+
+// goverter:converter
+type Converter interface {
+    // goverter:useZeroValueOnPointerInconsistency
+    ConvertHouse(source DBHouseNames) APIHouseNames
+}
+
+type DBHouseNames struct {
+    Names *[]string
+}
+
+type APIHouseNames struct {
+    Names []string
+}
@@ -0,0 +1,24 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+
+package generated
+
+import execution "github.com/jmattheis/goverter/execution"
+
+type ConverterImpl struct{}
+
+var ConverterConvert = ConverterImpl{}
+
+func (c *ConverterImpl) ConvertPerson(source execution.Person) execution.APIPerson {
+	var structsAPIPerson execution.APIPerson
+	structsAPIPerson.Info = c.pStructsPersonInfoToStructsAPIPersonInfo(source.Info)
+	return structsAPIPerson
+}
+func (c *ConverterImpl) pStructsPersonInfoToStructsAPIPersonInfo(source *execution.PersonInfo) execution.APIPersonInfo {
+	var structsAPIPersonInfo execution.APIPersonInfo
+	if source != nil {
+		if (*source).FirstName != nil {
+			structsAPIPersonInfo.FirstName = *(*source).FirstName
+		}
+	}
+	return structsAPIPersonInfo
+}
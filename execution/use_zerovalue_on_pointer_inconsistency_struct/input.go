@@ -0,0 +1,23 @@
+package structs
+
+// goverter:converter
+// goverter:useZeroValueOnPointerInconsistency
+type Converter interface {
+    ConvertPerson(source Person) APIPerson
+}
+
+type Person struct {
+    Info *PersonInfo
+}
+
+type PersonInfo struct {
+    FirstName *string
+}
+
+type APIPerson struct {
+    Info APIPersonInfo
+}
+
+type APIPersonInfo struct {
+    FirstName string
+}
@@ -0,0 +1,15 @@
+package structs
+
+// goverter:converter
+// goverter:useZeroValueOnPointerInconsistency
+type Converter interface {
+    ConvertPerson(source Input) Output
+}
+
+type Input struct {
+    Thing *struct{Name string}
+}
+
+type Output struct {
+    Thing struct{Name string}
+}
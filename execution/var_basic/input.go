@@ -0,0 +1,15 @@
+package example
+
+// goverter:variables
+var (
+    // goverter:map Name2 Name
+    conv func(Person) FlatPerson
+    Convert func([]Person) []FlatPerson
+)
+
+type FlatPerson struct {
+    Name    string
+}
+type Person struct {
+    Name2    string
+}
@@ -0,0 +1,22 @@
+package example
+
+type Enum1 int
+type Enum2 string
+
+const (
+    Enum1A Enum1 = iota
+    Enum1B
+)
+
+const (
+    Enum2A Enum2 = "A"
+    Enum2B Enum2 = "B"
+)
+
+// goverter:variables
+var (
+    // goverter:enum:unknown Enum2A
+    // goverter:enum:map Enum1A Enum2A
+    // goverter:enum:map Enum1B Enum2B
+    Convert func(Enum1) Enum2
+)
@@ -0,0 +1,13 @@
+package execution
+
+// goverter:variables
+// goverter:output:format function
+var (
+    Convert func(Input) Output
+)
+type Input struct {
+    Name       string
+}
+type Output struct {
+    Name       string
+}
@@ -0,0 +1,14 @@
+package example
+
+// goverter:variables
+// goverter:oops
+var (
+    conv func(Person) FlatPerson
+)
+
+type FlatPerson struct {
+    Name    string
+}
+type Person struct {
+    Name    string
+}
@@ -0,0 +1,6 @@
+package example
+
+// goverter:variables
+var (
+    Convert int
+)
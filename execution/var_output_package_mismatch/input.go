@@ -0,0 +1,16 @@
+package structs
+
+// goverter:variables
+// goverter:output:package pkg1
+var (
+    ConvertX func(source Input) Output
+)
+
+// goverter:variables
+// goverter:output:package pkg2
+var (
+    ConvertY func(source Input) Output
+)
+
+type Input struct { ID int }
+type Output struct { ID int }
@@ -0,0 +1,18 @@
+package example
+
+// goverter:variables
+var (
+    ConvertX func([]Input) []Output
+)
+
+// goverter:variables
+var (
+    ConvertY func([]Input) []Output
+)
+
+type Input struct {
+    Name string
+}
+type Output struct {
+    Name string
+}
@@ -0,0 +1,17 @@
+package structs
+
+// goverter:variables
+var (
+    Convert func(source Input) Output
+)
+
+type Input struct {
+    Name string
+}
+func (Input) Age(s string) int {
+    return 42
+}
+type Output struct {
+    Name string
+    Age int
+}
@@ -0,0 +1,13 @@
+package example
+
+// goverter:variables
+var (
+    Convert func([]Person) []FlatPerson
+)
+
+type FlatPerson struct {
+    Name    string
+}
+type Person struct {
+    Name    string
+}
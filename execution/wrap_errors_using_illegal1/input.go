@@ -0,0 +1,8 @@
+package structs
+
+// goverter:converter
+// goverter:extend strconv:Atoi
+// goverter:wrapErrors
+// goverter:wrapErrorsUsing github.com/jmattheis/goverter/execution/patherr
+type Converter interface {
+}
@@ -0,0 +1,17 @@
+package structs
+
+// goverter:converter
+// goverter:extend strconv:Atoi
+// goverter:wrapErrorsUsing github.com/jmattheis/goverter/execution/patherr
+type Converter interface {
+    Convert(Input) (Output, error)
+}
+
+type Input struct {
+    Values map[string]map[int]struct {Age string}
+    S struct {Names []map[bool]string}
+}
+type Output struct {
+    Values map[string]map[int]struct {Age int}
+    S struct {Names []map[bool]int}
+}
@@ -0,0 +1,6 @@
+package patherr
+
+func Key(any) any { return nil }
+func Index(int) any { return nil }
+func Field(string) any { return nil }
+func Wrap(error, ...any) error { return nil }
@@ -0,0 +1,232 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/jmattheis/goverter/builder"
+	"github.com/jmattheis/goverter/config"
+	"github.com/jmattheis/goverter/method"
+	"github.com/jmattheis/goverter/namer"
+	"github.com/jmattheis/goverter/xtype"
+)
+
+// Explanation describes the decisions goverter made while building a
+// conversion method.
+type Explanation struct {
+	Converter string
+	Method    string
+	// Settings are the settings defined on the method.
+	Settings []string
+	// Fields are the target field assignments of the method.
+	Fields []*builder.FieldReport
+	// Step is the conversion of the method source to the method target.
+	Step *ExplainStep
+}
+
+// ExplainStep is the decision for converting a source to a target type.
+type ExplainStep struct {
+	// Path is the target path relative to the method, e.g. Items[].ID.
+	Path   string
+	Source string
+	Target string
+	// Decision describes the used extend function or method, can be empty.
+	Decision string
+	// Reason describes why a new method was created, can be empty.
+	Reason string
+	// Builder is the builder from BuildSteps creating the conversion, can be
+	// empty.
+	Builder string
+	// Context are the context types passed to the called function or method.
+	Context []string
+	Steps   []*ExplainStep
+
+	pending bool
+}
+
+// Explain builds the converter and explains how the method with the given
+// name is created.
+func Explain(converter *config.Converter, methodName string) (*Explanation, error) {
+	gen, err := setupGenerator(converter, namer.New())
+	if err != nil {
+		return nil, err
+	}
+
+	var explained *generatedMethod
+	for _, genMethod := range gen.getGenMethods() {
+		if genMethod.Name == methodName {
+			explained = genMethod
+		}
+	}
+	if explained == nil {
+		return nil, fmt.Errorf("method %s does not exist on\n    %s", methodName, converter.IDString())
+	}
+
+	if err := validateMethods(gen.lookup); err != nil {
+		return nil, err
+	}
+
+	gen.trace = &tracer{root: explained}
+	if err := gen.buildMethods(jen.NewFile(converter.OutputPackageName)); err != nil {
+		return nil, err
+	}
+
+	return &Explanation{
+		Converter: converter.IDString(),
+		Method:    explained.ID,
+		Settings:  explained.RawSettings,
+		Fields:    explained.Report.Fields,
+		Step:      gen.trace.result,
+	}, nil
+}
+
+// Text renders the explanation as indented tree.
+func (e *Explanation) Text() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Converter:\n    %s\n\nMethod:\n    %s\n", e.Converter, e.Method)
+	if len(e.Settings) > 0 {
+		b.WriteString("\nSettings:\n")
+		for _, setting := range e.Settings {
+			fmt.Fprintf(&b, "    goverter:%s\n", setting)
+		}
+	}
+	if e.Step != nil {
+		b.WriteString("\nDecisions:\n")
+		writeStep(&b, e.Step, 1)
+	}
+	if len(e.Fields) > 0 {
+		b.WriteString("\nFields:\n")
+		for _, f := range e.Fields {
+			b.WriteString("    " + f.Target)
+			switch {
+			case f.Source != "" && f.Function != "":
+				b.WriteString(" <- " + f.Source + " | " + f.Function)
+			case f.Function != "":
+				b.WriteString(" <- " + f.Function)
+			case f.Source != "":
+				b.WriteString(" <- " + f.Source)
+			}
+			if notes := fieldNotes(f); notes != "" {
+				b.WriteString(" (" + notes + ")")
+			}
+			b.WriteString("\n")
+		}
+	}
+	return b.String()
+}
+
+func writeStep(b *strings.Builder, step *ExplainStep, depth int) {
+	details := []string{}
+	if step.Decision != "" {
+		if step.Reason != "" {
+			details = append(details, fmt.Sprintf("%s (%s)", step.Decision, step.Reason))
+		} else {
+			details = append(details, step.Decision)
+		}
+	}
+	if step.Builder != "" {
+		details = append(details, "builder "+step.Builder)
+	}
+	if len(step.Context) > 0 {
+		details = append(details, "context "+strings.Join(step.Context, ", "))
+	}
+
+	b.WriteString(strings.Repeat("    ", depth))
+	if step.Path != "" {
+		b.WriteString(step.Path + ": ")
+	}
+	fmt.Fprintf(b, "%s -> %s", step.Source, step.Target)
+	if len(details) > 0 {
+		b.WriteString(": " + strings.Join(details, ", "))
+	}
+	b.WriteString("\n")
+
+	for _, child := range step.Steps {
+		writeStep(b, child, depth+1)
+	}
+}
+
+// tracer records the decisions while building the root method. All methods
+// can be called on a nil tracer.
+type tracer struct {
+	root   *generatedMethod
+	result *ExplainStep
+	stack  []*ExplainStep
+}
+
+func (t *tracer) begin(genMethod *generatedMethod) bool {
+	if t == nil || genMethod != t.root {
+		return false
+	}
+	t.result = &ExplainStep{Source: typeString(genMethod.Source), Target: genMethod.Target.String}
+	t.stack = []*ExplainStep{t.result}
+	return true
+}
+
+func (t *tracer) end() {
+	t.stack = nil
+}
+
+func (t *tracer) current() *ExplainStep {
+	if t == nil || len(t.stack) == 0 {
+		return nil
+	}
+	return t.stack[len(t.stack)-1]
+}
+
+// push adds a step for the conversion and returns a function removing it
+// from the stack again.
+func (t *tracer) push(path builder.ErrorPath, source, target *xtype.Type) func() {
+	parent := t.current()
+	if parent == nil {
+		return func() {}
+	}
+	step := &ExplainStep{Path: path.String(), Source: typeString(source), Target: target.String}
+	parent.Steps = append(parent.Steps, step)
+	t.stack = append(t.stack, step)
+	return func() { t.stack = t.stack[:len(t.stack)-1] }
+}
+
+// decide sets the decision of the current step. The context of the next
+// called method is added to this step.
+func (t *tracer) decide(decision, reason string) {
+	if step := t.current(); step != nil && step.Decision == "" {
+		step.Decision = decision
+		step.Reason = reason
+		step.pending = true
+	}
+}
+
+func (t *tracer) builder(rule builder.Builder) {
+	if step := t.current(); step != nil && step.Builder == "" {
+		step.Builder = strings.TrimPrefix(fmt.Sprintf("%T", rule), "*builder.")
+	}
+}
+
+// call records a call of the definition. Calls without a prior decision e.g.
+// functions of goverter:map are added as separate step.
+func (t *tracer) call(path builder.ErrorPath, def *method.Definition, source, target *xtype.Type) {
+	step := t.current()
+	if step == nil {
+		return
+	}
+	if !step.pending {
+		child := &ExplainStep{Path: path.String(), Source: typeString(source), Target: target.String, Decision: "function " + builder.FunctionName(def)}
+		step.Steps = append(step.Steps, child)
+		step = child
+	}
+	step.pending = false
+	for _, arg := range def.RawArgs {
+		if arg.Use == method.ArgUseContext {
+			step.Context = append(step.Context, arg.Type.String)
+		}
+	}
+}
+
+func typeString(t *xtype.Type) string {
+	if t == nil {
+		return "(none)"
+	}
+	return t.String
+}
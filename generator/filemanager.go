@@ -4,12 +4,32 @@ import (
 	"bytes"
 	"fmt"
 	"path/filepath"
+	"strings"
+	"unicode"
 
 	"github.com/dave/jennifer/jen"
 	"github.com/jmattheis/goverter/config"
 	"github.com/jmattheis/goverter/namer"
 )
 
+// Header is the first line of all files generated by goverter.
+const Header = "// Code generated by github.com/jmattheis/goverter, DO NOT EDIT."
+
+// IsGenerated returns true if the file content contains the Header in the
+// comments above the package clause.
+func IsGenerated(content []byte) bool {
+	for _, line := range strings.Split(string(content), "\n") {
+		line = strings.TrimSpace(line)
+		if line == Header {
+			return true
+		}
+		if strings.HasPrefix(line, "package ") {
+			return false
+		}
+	}
+	return false
+}
+
 type fileManager struct {
 	Files map[string]*managedFile
 }
@@ -19,17 +39,38 @@ type managedFile struct {
 	Initial   *config.Converter
 	Content   *jen.File
 	Namer     *namer.Namer
+	// SharedFuncs maps the rendered helper methods shared by the converters
+	// to the function name.
+	SharedFuncs map[string]string
 }
 
 func (m *fileManager) Get(conv *config.Converter, cfg Config) (*jen.File, *namer.Namer, error) {
-	output := getOutputDir(conv)
+	return m.get(getOutputDir(conv), conv, cfg)
+}
 
+// GetSplit returns the file for the methods with the given key, if the
+// converter uses output:split. The file is next to the output file e.g.
+// generated_key.go for generated.go.
+func (m *fileManager) GetSplit(conv *config.Converter, cfg Config, key string) (string, *jen.File, error) {
+	path := splitPath(getOutputDir(conv), key)
+	f, _, err := m.get(path, conv, cfg)
+	return path, f, err
+}
+
+func (m *fileManager) get(output string, conv *config.Converter, cfg Config) (*jen.File, *namer.Namer, error) {
 	f, ok := m.Files[output]
 	if !ok {
+		header, err := conv.Header()
+		if err != nil {
+			return nil, nil, fmt.Errorf("Error rendering output:header of converter\n    %s\n    %s\n\n%s", conv.Location, conv.IDString(), err)
+		}
+
 		f = &managedFile{
 			PackageID: conv.PackageID(),
 			Initial:   conv,
 			Namer:     namer.New(),
+
+			SharedFuncs: map[string]string{},
 		}
 
 		if conv.OutputPackageName == "" {
@@ -38,7 +79,10 @@ func (m *fileManager) Get(conv *config.Converter, cfg Config) (*jen.File, *namer
 			f.Content = jen.NewFilePathName(conv.OutputPackagePath, conv.OutputPackageName)
 		}
 
-		f.Content.HeaderComment("// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.")
+		if header != "" {
+			f.Content.HeaderComment(header)
+		}
+		f.Content.HeaderComment(Header)
 		if cfg.BuildConstraint != "" {
 			f.Content.HeaderComment("//go:build " + cfg.BuildConstraint)
 		}
@@ -53,6 +97,12 @@ func (m *fileManager) Get(conv *config.Converter, cfg Config) (*jen.File, *namer
 	return f.Content, f.Namer, nil
 }
 
+// SharedFuncs returns the shared helper functions of the output file of the
+// converter.
+func (m *fileManager) SharedFuncs(conv *config.Converter) map[string]string {
+	return m.Files[getOutputDir(conv)].SharedFuncs
+}
+
 func (m *fileManager) renderFiles() (map[string][]byte, error) {
 	result := map[string][]byte{}
 	for name, f := range m.Files {
@@ -65,10 +115,29 @@ func (m *fileManager) renderFiles() (map[string][]byte, error) {
 	return result, nil
 }
 
+// splitPath returns the file for the key next to the output file. The _gen
+// suffix prevents keys like test, linux or amd64 from turning the file into a
+// test file or adding an implicit build constraint.
+func splitPath(output, key string) string {
+	key = strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return '_'
+	}, key)
+	ext := filepath.Ext(output)
+	return strings.TrimSuffix(output, ext) + "_" + key + "_gen" + ext
+}
+
 func getOutputDir(c *config.Converter) string {
-	if filepath.IsAbs(c.OutputFile) {
-		return c.OutputFile
+	return getOutputPath(c, c.OutputFile)
+}
+
+// getOutputPath resolves file relative to the directory of the converter.
+func getOutputPath(c *config.Converter, file string) string {
+	if filepath.IsAbs(file) {
+		return file
 	}
 
-	return filepath.Join(filepath.Dir(c.FileName), c.OutputFile)
+	return filepath.Join(filepath.Dir(c.FileName), file)
 }
@@ -4,18 +4,31 @@ import (
 	"github.com/dave/jennifer/jen"
 	"github.com/jmattheis/goverter/builder"
 	"github.com/jmattheis/goverter/config"
+	"github.com/jmattheis/goverter/diagnostic"
+	"github.com/jmattheis/goverter/method"
 	"github.com/jmattheis/goverter/namer"
 )
 
 // Config the generate config.
 type Config struct {
 	BuildConstraint string
+	// Report is filled with the field mappings of all converters, can be nil.
+	Report *Report
+	// KeepGoing collects the errors of all converters and methods instead of
+	// returning the first one. The files of converters without errors are
+	// still returned.
+	KeepGoing bool
+	// Warn is called for each warning e.g. unused settings, can be nil.
+	Warn func(*diagnostic.Diagnostic)
+	// Strict turns warnings into errors.
+	Strict bool
 }
 
 // BuildSteps that'll used for generation.
 var BuildSteps = []builder.Builder{
 	&builder.UseUnderlyingTypeMethods{},
 	&builder.SkipCopy{},
+	&builder.TypeParam{},
 	&builder.Enum{},
 	&builder.BasicTargetPointerRule{},
 	&builder.Pointer{},
@@ -30,33 +43,105 @@ var BuildSteps = []builder.Builder{
 // Generate generates a jen.File containing converters.
 func Generate(converters []*config.Converter, c Config) (map[string][]byte, error) {
 	manager := &fileManager{Files: map[string]*managedFile{}}
+	docs := map[string]*Report{}
+	failed := map[string]struct{}{}
+	errs := []error{}
 
 	for _, converter := range converters {
 		jenFile, n, err := manager.Get(converter, c)
 		if err != nil {
-			return nil, err
+			if !c.KeepGoing {
+				return nil, err
+			}
+			errs = append(errs, err)
+			continue
 		}
 
-		if err := generateConverter(converter, jenFile, n); err != nil {
-			return nil, err
+		var splitPaths []string
+		splitFile := func(key string) (*jen.File, error) {
+			path, f, err := manager.GetSplit(converter, c, key)
+			splitPaths = append(splitPaths, path)
+			return f, err
+		}
+
+		report, warnings, err := generateConverter(converter, jenFile, n, splitFile, manager.SharedFuncs(converter), c.KeepGoing)
+		if err == nil && c.Strict && len(warnings) > 0 {
+			warnErrs := make([]error, 0, len(warnings))
+			for _, warning := range warnings {
+				warnErrs = append(warnErrs, warning.AsError())
+			}
+			err = diagnostic.Join(warnErrs...)
+		}
+		if err != nil {
+			if !c.KeepGoing {
+				return nil, err
+			}
+			errs = append(errs, err)
+			failed[getOutputDir(converter)] = struct{}{}
+			for _, path := range splitPaths {
+				failed[path] = struct{}{}
+			}
+			if converter.OutputDocs != "" {
+				failed[getOutputPath(converter, converter.OutputDocs)] = struct{}{}
+			}
+			continue
+		}
+		if c.Warn != nil {
+			for _, warning := range warnings {
+				c.Warn(warning)
+			}
+		}
+
+		if c.Report != nil {
+			c.Report.Converters = append(c.Report.Converters, report)
+		}
+		if converter.OutputDocs != "" {
+			path := getOutputPath(converter, converter.OutputDocs)
+			if _, ok := docs[path]; !ok {
+				docs[path] = &Report{}
+			}
+			docs[path].Converters = append(docs[path].Converters, report)
 		}
 	}
 
-	return manager.renderFiles()
+	for path := range failed {
+		delete(manager.Files, path)
+		delete(docs, path)
+	}
+
+	files, err := manager.renderFiles()
+	if err != nil {
+		return nil, err
+	}
+	for path, report := range docs {
+		if files[path], err = report.Docs(path); err != nil {
+			return nil, err
+		}
+	}
+	return files, diagnostic.Join(errs...)
 }
 
-func generateConverter(converter *config.Converter, f *jen.File, n *namer.Namer) error {
+func generateConverter(converter *config.Converter, f *jen.File, n *namer.Namer, splitFile func(string) (*jen.File, error), sharedFuncs map[string]string, keepGoing bool) (*ConverterReport, []*diagnostic.Diagnostic, error) {
 	gen, err := setupGenerator(converter, n)
 	if err != nil {
-		return err
+		return nil, nil, err
+	}
+	gen.keepGoing = keepGoing
+	gen.splitFile = splitFile
+	if converter.OutputShareHelpers {
+		gen.sharedFuncs = sharedFuncs
+		gen.helperRefs = map[*method.Definition]*jen.Statement{}
 	}
 
 	if err := validateMethods(gen.lookup); err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	if err := gen.buildMethods(f); err != nil {
-		return err
+		return nil, nil, err
+	}
+	if err := validateIgnorePatterns(converter); err != nil {
+		return nil, nil, err
 	}
-	return nil
+	return newConverterReport(converter, gen.getGenMethods()), append(converter.Warnings, gen.unusedSettings()...), nil
 }
@@ -1,6 +1,7 @@
 package generator
 
 import (
+	"bytes"
 	"fmt"
 	"go/types"
 	"sort"
@@ -9,6 +10,7 @@ import (
 	"github.com/dave/jennifer/jen"
 	"github.com/jmattheis/goverter/builder"
 	"github.com/jmattheis/goverter/config"
+	"github.com/jmattheis/goverter/diagnostic"
 	"github.com/jmattheis/goverter/method"
 	"github.com/jmattheis/goverter/namer"
 	"github.com/jmattheis/goverter/xtype"
@@ -22,6 +24,13 @@ type generatedMethod struct {
 
 	OriginPath []method.IndexID
 	Jen        jen.Code
+	Report     *builder.MethodReport
+	Usage      *builder.SettingUsage
+
+	// Calls are the generated methods called by this method.
+	Calls []*method.Definition
+	// UsesConverter is true if the converter is passed to a called function.
+	UsesConverter bool
 
 	IndexID method.IndexID
 }
@@ -31,6 +40,24 @@ type generator struct {
 	conf   *config.Converter
 	lookup *method.Index[generatedMethod]
 	extend *method.Index[method.Definition]
+
+	keepGoing  bool
+	usedExtend map[*method.Definition]struct{}
+	// trace records the decisions for goverter explain, can be nil.
+	trace *tracer
+	// splitFile returns the file for the methods with the key when using
+	// output:split, can be nil to write all methods into one file.
+	splitFile func(key string) (*jen.File, error)
+	// sharedFuncs maps the rendered helper methods to the name of the shared
+	// function of the output file, it's nil if output:shareHelpers is disabled.
+	sharedFuncs map[string]string
+	// helperRefs contains the references to the helper methods, they are
+	// resolved when appending the methods to the file.
+	helperRefs map[*method.Definition]*jen.Statement
+	// typeParamsDecl and typeParamsUse are the type parameters of generic
+	// converters, they're empty otherwise.
+	typeParamsDecl []jen.Code
+	typeParamsUse  []jen.Code
 }
 
 func (g *generator) getGenMethods() []*generatedMethod {
@@ -42,16 +69,23 @@ func (g *generator) getGenMethods() []*generatedMethod {
 }
 
 func (g *generator) buildMethods(f *jen.File) error {
+	errs := []error{}
 	for g.anyDirty() {
 		if err := g.buildDirtyMethods(); err != nil {
-			return err
+			if !g.keepGoing {
+				return err
+			}
+			errs = append(errs, err)
 		}
 	}
-	g.appendGenerated(f)
-	return nil
+	if len(errs) > 0 {
+		return diagnostic.Join(errs...)
+	}
+	return g.appendGenerated(f)
 }
 
 func (g *generator) buildDirtyMethods() error {
+	errs := []error{}
 	for _, genMethod := range g.getGenMethods() {
 		if !genMethod.Dirty {
 			continue
@@ -65,10 +99,13 @@ func (g *generator) buildDirtyMethods() error {
 				SourceType: genMethod.Source.String,
 				TargetType: genMethod.Target.String,
 			})
-			return fmt.Errorf("Error while creating converter method:\n    %s\n    %s%s\n\n%s", genMethod.Location, genMethod.ID, genMethod.Definition.ArgDebug("        "), builder.ToString(err))
+			if !g.keepGoing {
+				return methodError(genMethod, err)
+			}
+			errs = append(errs, methodError(genMethod, err))
 		}
 	}
-	return nil
+	return diagnostic.Join(errs...)
 }
 
 func (g *generator) anyDirty() bool {
@@ -80,8 +117,12 @@ func (g *generator) anyDirty() bool {
 	return false
 }
 
-func (g *generator) appendGenerated(f *jen.File) {
+func (g *generator) appendGenerated(f *jen.File) error {
 	genMethods := g.getGenMethods()
+	shared, err := g.shareHelpers(genMethods)
+	if err != nil {
+		return err
+	}
 	for _, raw := range g.conf.OutputRaw {
 		f.Id(raw)
 	}
@@ -90,36 +131,59 @@ func (g *generator) appendGenerated(f *jen.File) {
 		if len(g.conf.Comments) > 0 {
 			f.Comment(strings.Join(g.conf.Comments, "\n"))
 		}
-		f.Type().Id(g.conf.Name).Struct()
-		
-		// 生成单例变量，例如: var ConverterConvert = ConverterImpl{}
-		singletonName := strings.TrimSuffix(g.conf.Name, "Impl")
-		if singletonName == g.conf.Name {
-			// 如果名称不以 Impl 结尾，则使用原名称
-			singletonName = g.conf.Name
+		f.Type().Id(g.conf.Name).Add(g.withTypeParams(g.typeParamsDecl)).Struct()
+		if g.conf.Singleton {
+			instance := jen.Id(g.conf.Name).Values()
+			if g.conf.SingletonPointer {
+				instance = jen.Op("&").Add(instance)
+			}
+			f.Var().Id(g.conf.SingletonID()).Op("=").Add(instance)
 		}
-		// 确保首字母大写并添加 Convert 后缀
-		if len(singletonName) > 0 {
-			singletonName = strings.ToUpper(singletonName[:1]) + singletonName[1:] + "Convert"
+
+		if iface := g.conf.Interface(); iface != nil {
+			ifaceType := xtype.TypeOf(iface).TypeAsJen().Add(g.withTypeParams(g.typeParamsUse))
+			if g.conf.OutputAssert {
+				assert := jen.Var().Id("_").Add(ifaceType.Clone()).Op("=").Parens(jen.Op("*").Add(g.implType())).Call(jen.Nil())
+				if len(g.typeParamsDecl) > 0 {
+					// generic types can only be instantiated inside a generic function.
+					f.Func().Id("_").Types(g.typeParamsDecl...).Params().Block(assert)
+				} else {
+					f.Add(assert)
+				}
+			}
+			if g.conf.OutputConstructor {
+				f.Func().Id(g.conf.ConstructorID()).Add(g.withTypeParams(g.typeParamsDecl)).Params().Add(ifaceType.Clone()).Block(
+					jen.Return(jen.Op("&").Add(g.implType()).Values()),
+				)
+			}
 		}
-		f.Var().Id(singletonName).Op("=").Id(g.conf.Name).Values()
 	}
 
 	var init []jen.Code
-	var funcs []jen.Code
+	type fileFunc struct {
+		def *generatedMethod
+		fn  jen.Code
+	}
+	var funcs []fileFunc
 
 	for _, def := range genMethods {
+		if emit, ok := shared[def]; ok {
+			if emit {
+				funcs = append(funcs, fileFunc{def, jen.Func().Id(def.Name).Add(def.Jen)})
+			}
+			continue
+		}
 		switch g.conf.OutputFormat {
 		case config.FormatStruct:
-			funcs = append(funcs, jen.Func().Params(jen.Id(xtype.ThisVar).Op("*").Id(g.conf.Name)).Id(def.Name).Add(def.Jen))
+			funcs = append(funcs, fileFunc{def, jen.Func().Params(jen.Id(xtype.ThisVar).Op("*").Add(g.implType())).Id(def.Name).Add(def.Jen)})
 		case config.FormatVariable:
 			if def.Explicit {
 				init = append(init, jen.Qual(def.Package, def.Name).Op("=").Func().Add(def.Jen))
 			} else {
-				funcs = append(funcs, jen.Func().Id(def.Name).Add(def.Jen))
+				funcs = append(funcs, fileFunc{def, jen.Func().Id(def.Name).Add(def.Jen)})
 			}
 		case config.FormatFunction:
-			funcs = append(funcs, jen.Func().Id(def.Name).Add(def.Jen))
+			funcs = append(funcs, fileFunc{def, jen.Func().Id(def.Name).Add(g.withTypeParams(g.typeParamsDecl)).Add(def.Jen)})
 		}
 	}
 
@@ -128,7 +192,136 @@ func (g *generator) appendGenerated(f *jen.File) {
 	}
 
 	for _, fn := range funcs {
-		f.Add(fn)
+		target := f
+		if key := g.splitKey(fn.def); key != "" && g.splitFile != nil {
+			var err error
+			if target, err = g.splitFile(key); err != nil {
+				return err
+			}
+		}
+		target.Add(fn.fn)
+	}
+	return nil
+}
+
+// shareHelpers resolves the references to the helper methods. Helpers that
+// don't use the converter are written as functions shared with the other
+// converters of the output file. The result contains the shared helpers, true
+// if the function must be written and false if an identical function
+// already exists.
+func (g *generator) shareHelpers(genMethods []*generatedMethod) (map[*generatedMethod]bool, error) {
+	if g.helperRefs == nil {
+		return nil, nil
+	}
+
+	byDef := map[*method.Definition]*generatedMethod{}
+	for _, def := range genMethods {
+		byDef[def.Definition] = def
+	}
+
+	const (
+		visiting = iota + 1
+		sharable
+		notSharable
+	)
+	state := map[*generatedMethod]int{}
+	shared := map[*generatedMethod]bool{}
+
+	var visit func(def *generatedMethod) (bool, error)
+	visit = func(def *generatedMethod) (bool, error) {
+		switch state[def] {
+		case visiting:
+			// recursive helpers aren't shared
+			return false, nil
+		case sharable:
+			return true, nil
+		case notSharable:
+			return false, nil
+		}
+		state[def] = visiting
+
+		ok := !def.Explicit && !def.UsesConverter
+		for _, call := range def.Calls {
+			callee, exists := byDef[call]
+			switch {
+			case !exists:
+			case callee.Explicit:
+				ok = ok && g.conf.OutputFormat != config.FormatStruct
+			default:
+				calleeOK, err := visit(callee)
+				if err != nil {
+					return false, err
+				}
+				ok = ok && calleeOK
+			}
+		}
+
+		if !ok {
+			state[def] = notSharable
+			return false, nil
+		}
+		state[def] = sharable
+
+		var buf bytes.Buffer
+		if err := jen.Func().Id("_").Add(def.Jen).Render(&buf); err != nil {
+			return false, err
+		}
+		key := buf.String()
+		if name, exists := g.sharedFuncs[key]; exists {
+			def.Name = name
+			shared[def] = false
+		} else {
+			g.sharedFuncs[key] = def.Name
+			shared[def] = true
+		}
+		g.helperRefs[def.Definition].Id(def.Name)
+		return true, nil
+	}
+
+	for _, def := range genMethods {
+		if _, err := visit(def); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, def := range genMethods {
+		ref, ok := g.helperRefs[def.Definition]
+		if !ok || state[def] == sharable {
+			continue
+		}
+		if g.conf.OutputFormat == config.FormatStruct {
+			ref.Id(xtype.ThisVar).Dot(def.Name)
+		} else {
+			ref.Id(def.Name)
+		}
+	}
+	return shared, nil
+}
+
+// splitKey returns the key of the file the method is written to, it's empty
+// if the method is written into the output file.
+func (g *generator) splitKey(def *generatedMethod) string {
+	switch g.conf.OutputSplit {
+	case config.SplitMethod:
+		return def.Name
+	case config.SplitType:
+		t := def.Target
+		for {
+			switch {
+			case t.Named:
+				return t.NamedType.Obj().Name()
+			case t.Pointer:
+				t = t.PointerInner
+			case t.List:
+				t = t.ListInner
+			case t.Map:
+				t = t.MapValue
+			default:
+				return "other"
+			}
+		}
+	default:
+		return ""
 	}
 }
 
@@ -155,6 +348,23 @@ func (g *generator) buildMethod(genMethod *generatedMethod, context map[string]*
 		HasMethod:         g.hasMethod,
 		OutputPackagePath: g.conf.OutputPackagePath,
 		UseConstructor:    genMethod.Constructor != nil,
+		Report:            &builder.MethodReport{Name: genMethod.Name, Target: target.String},
+	}
+	if source != nil {
+		ctx.Report.Source = source.String
+	}
+	if params := g.conf.TypeParams(); params != nil {
+		for i := 0; i < params.Len(); i++ {
+			ctx.Namer.Reserve(params.At(i).Obj().Name())
+		}
+	}
+	genMethod.Report = ctx.Report
+	genMethod.Calls = nil
+	genMethod.UsesConverter = false
+	ctx.Usage = builder.NewSettingUsage(genMethod.AutoMap)
+	genMethod.Usage = ctx.Usage
+	if g.trace.begin(genMethod) {
+		defer g.trace.end()
 	}
 
 	var targetAssign *jen.Statement
@@ -164,7 +374,12 @@ func (g *generator) buildMethod(genMethod *generatedMethod, context map[string]*
 		case method.ArgUseInterface:
 			panic("hopefully unreachable")
 		case method.ArgUseContext:
-			name := ctx.Name("context")
+			name := "context"
+			if arg.Type.String == xtype.ContextType {
+				// don't shadow the context package.
+				name = "ctx"
+			}
+			name = ctx.Name(name)
 			ctx.Context[arg.Type.String] = xtype.VariableID(jen.Id(name))
 			args = append(args, jen.Id(name).Add(arg.Type.TypeAsJen()))
 		case method.ArgUseSource:
@@ -203,6 +418,9 @@ func (g *generator) buildMethod(genMethod *generatedMethod, context map[string]*
 			funcBlock = append(funcBlock, jen.Return().Nil())
 		}
 	} else if def, err := g.extend.Get(ctx.Signature, context); def != nil {
+		g.usedExtend[def] = struct{}{}
+		g.trace.decide("extend "+builder.FunctionName(def), "")
+		g.trace.call(nil, def, source, target)
 		jenReturn, err := g.delegateMethod(ctx, def, sourceID)
 		if err != nil {
 			return err
@@ -235,6 +453,7 @@ func (g *generator) buildNoLookup(ctx *builder.MethodContext, sourceID *xtype.Je
 
 	for _, rule := range BuildSteps {
 		if rule.Matches(ctx, source, target) {
+			g.trace.builder(rule)
 			return rule.Build(g, ctx, sourceID, source, target, errPath)
 		}
 	}
@@ -249,6 +468,7 @@ func (g *generator) assignNoLookup(ctx *builder.MethodContext, assignTo *builder
 
 	for _, rule := range BuildSteps {
 		if rule.Matches(ctx, source, target) {
+			g.trace.builder(rule)
 			return rule.Assign(g, ctx, assignTo, sourceID, source, target, errPath)
 		}
 	}
@@ -271,6 +491,7 @@ func (g *generator) convertTo(ctx *builder.MethodContext, assignTo *builder.Assi
 	}
 
 	var s builder.Struct
+	g.trace.builder(&s)
 	stmt, err := s.Assign(g, ctx, assignTo, sourceID, source, target.PointerInner, errPath)
 	if sourcePointer {
 		stmt = []jen.Code{jen.If(sourceID.Code.Clone().Op("!=").Nil()).Block(stmt...)}
@@ -285,11 +506,15 @@ func (g *generator) CallMethod(
 	source, target *xtype.Type,
 	errPath builder.ErrorPath,
 ) ([]jen.Code, *xtype.JenID, *builder.Error) {
+	g.trace.call(errPath, definition, source, target)
 	params := []jen.Code{}
 	formatErr := func(s string) *builder.Error {
 		return builder.NewError(fmt.Sprintf("Error using method:\n    %s%s\n\n%s", definition.ID, definition.ArgDebug("        "), s))
 	}
 
+	current := g.lookup.ByID(ctx.IndexID)
+	g.recordCall(current, definition)
+
 	for _, arg := range definition.RawArgs {
 		switch arg.Use {
 		case method.ArgUseInterface:
@@ -362,6 +587,20 @@ func (g *generator) ReturnError(ctx *builder.MethodContext, errPath builder.Erro
 	return jen.Return(returns...), true
 }
 
+func (g *generator) CanReturnError(ctx *builder.MethodContext) bool {
+	if ctx.Conf.ReturnError {
+		return true
+	}
+	current := g.lookup.ByID(ctx.IndexID)
+	for _, path := range append([]method.IndexID{ctx.IndexID}, current.OriginPath...) {
+		check := g.lookup.ByID(path)
+		if check.Explicit && !check.ReturnError {
+			return false
+		}
+	}
+	return true
+}
+
 func (g *generator) requireContext(ctx *builder.MethodContext, need *xtype.Type) bool {
 	if _, ok := ctx.Context[need.String]; ok {
 		return true
@@ -396,6 +635,8 @@ func (g *generator) delegateMethod(
 	sourceID *xtype.JenID,
 ) (*jen.Statement, *builder.Error) {
 	params := []jen.Code{}
+	current := g.lookup.ByID(ctx.IndexID)
+	g.recordCall(current, delegateTo)
 
 	for _, arg := range delegateTo.RawArgs {
 		switch arg.Use {
@@ -412,8 +653,6 @@ func (g *generator) delegateMethod(
 		}
 	}
 
-	current := g.lookup.ByID(ctx.IndexID)
-
 	returns := []jen.Code{g.qualMethod(delegateTo).Call(params...)}
 
 	if delegateTo.ReturnError {
@@ -428,6 +667,19 @@ func (g *generator) delegateMethod(
 	return jen.Return(returns...), nil
 }
 
+// recordCall records the call to the definition, it's used to find the helper
+// methods that can be shared.
+func (g *generator) recordCall(current *generatedMethod, definition *method.Definition) {
+	if definition.Generated && definition.CustomCall == nil {
+		current.Calls = append(current.Calls, definition)
+	}
+	for _, arg := range definition.RawArgs {
+		if arg.Use == method.ArgUseInterface {
+			current.UsesConverter = true
+		}
+	}
+}
+
 // wrap invokes the error wrapper if feature is enabled.
 func (g *generator) wrap(ctx *builder.MethodContext, errPath builder.ErrorPath, errStmt *jen.Statement) *jen.Statement {
 	switch {
@@ -447,13 +699,20 @@ func (g *generator) Build(
 	source, target *xtype.Type,
 	errPath builder.ErrorPath,
 ) ([]jen.Code, *xtype.JenID, *builder.Error) {
+	defer g.trace.push(errPath, source, target)()
+
 	stmt, nextID, err := g.callExisting(ctx, sourceID, source, target, errPath)
 	if nextID != nil || err != nil {
 		return stmt, nextID, err
 	}
 
-	if g.shouldCreateSubMethod(ctx, source, target) {
-		return g.createSubMethod(ctx, sourceID, source, target, errPath)
+	if reason := g.subMethodReason(ctx, source, target); reason != "" {
+		return g.createSubMethod(ctx, sourceID, source, target, errPath, reason)
+	}
+	if g.hasRequired(ctx, target.T) {
+		// only types on the current path are recursive, sibling fields may
+		// use the same type.
+		defer ctx.UnmarkSeen(source)
 	}
 
 	return g.buildNoLookup(ctx, sourceID, source, target, errPath)
@@ -471,13 +730,18 @@ func (g *generator) Assign(
 		return builder.ToAssignable(assignTo)(g.Build(ctx, sourceID, source, target, errPath))
 	}
 
+	defer g.trace.push(errPath, source, target)()
+
 	stmt, nextID, err := g.callExisting(ctx, sourceID, source, target, errPath)
 	if nextID != nil || err != nil {
 		return builder.ToAssignable(assignTo)(stmt, nextID, err)
 	}
 
-	if g.shouldCreateSubMethod(ctx, source, target) {
-		return builder.ToAssignable(assignTo)(g.createSubMethod(ctx, sourceID, source, target, errPath))
+	if reason := g.subMethodReason(ctx, source, target); reason != "" {
+		return builder.ToAssignable(assignTo)(g.createSubMethod(ctx, sourceID, source, target, errPath, reason))
+	}
+	if g.hasRequired(ctx, target.T) {
+		defer ctx.UnmarkSeen(source)
 	}
 
 	return g.assignNoLookup(ctx, assignTo, sourceID, source, target, errPath)
@@ -491,19 +755,187 @@ func (g generator) callExisting(
 ) ([]jen.Code, *xtype.JenID, *builder.Error) {
 	signature := xtype.SignatureOf(source, target)
 	if def, err := g.extend.Get(signature, ctx.AvailableContext); def != nil {
+		g.usedExtend[def] = struct{}{}
+		g.trace.decide("extend "+builder.FunctionName(def), "")
 		return g.CallMethod(ctx, def, sourceID, source, target, errPath)
 	} else if err != nil {
 		return nil, nil, builder.NewError(err.Error())
 	}
-	if genMethod, err := g.lookup.Get(signature, ctx.AvailableContext); genMethod != nil {
+	genMethod, lookupErr := g.lookup.Get(signature, ctx.AvailableContext)
+	if genMethod != nil && genMethod.Explicit {
+		g.trace.decide("method "+genMethod.Name, "")
 		return g.CallMethod(ctx, genMethod.Definition, sourceID, source, target, errPath)
+	}
+	if def, err := g.contextFunc(ctx, source, target); def != nil {
+		g.trace.decide("context "+def.ID, "")
+		return g.CallMethod(ctx, def, sourceID, source, target, errPath)
 	} else if err != nil {
-		return nil, nil, builder.NewError(err.Error())
+		return nil, nil, err
+	}
+	if genMethod != nil && !g.hasRequired(ctx, target.T) {
+		g.trace.decide("method "+genMethod.Name, "")
+		return g.CallMethod(ctx, genMethod.Definition, sourceID, source, target, errPath)
+	} else if lookupErr != nil {
+		return nil, nil, builder.NewError(lookupErr.Error())
 	}
 	return nil, nil, nil
 }
 
-func (g *generator) shouldCreateSubMethod(ctx *builder.MethodContext, source, target *xtype.Type) bool {
+func (g *generator) ContextParam(ctx *builder.MethodContext, typ string) (*jen.Statement, bool) {
+	contextType, ok := ctx.AvailableContext[typ]
+	if !ok || !g.requireContext(ctx, contextType) {
+		return nil, false
+	}
+	if id, ok := ctx.Context[typ]; ok {
+		return id.Code.Clone(), true
+	}
+	// the method is rebuilt, because the context parameter was just added.
+	return jen.Id("_"), true
+}
+
+// contextFunc returns a definition calling the context parameter with a
+// function type converting source to target, nil if no context parameter
+// matches. Declared methods take precedence over context functions. Context
+// functions are only used for types with type parameters of generic
+// converters, other conversions don't depend on the type arguments.
+func (g *generator) contextFunc(ctx *builder.MethodContext, source, target *xtype.Type) (*method.Definition, *builder.Error) {
+	if !hasTypeParam(source.T) && !hasTypeParam(target.T) {
+		return nil, nil
+	}
+
+	var matches []string
+	returnError := map[string]bool{}
+	for key, contextType := range ctx.AvailableContext {
+		if !contextType.Signature {
+			continue
+		}
+		sig := contextType.SignatureType
+		if sig.Params().Len() != 1 || sig.Variadic() || !types.Identical(sig.Params().At(0).Type(), source.T) {
+			continue
+		}
+		results := sig.Results()
+		returnError[key] = results.Len() == 2 && types.Identical(results.At(1).Type(), types.Universe.Lookup("error").Type())
+		if (results.Len() != 1 && !returnError[key]) || !types.Identical(results.At(0).Type(), target.T) {
+			continue
+		}
+		matches = append(matches, key)
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, nil
+	case 1:
+	default:
+		sort.Strings(matches)
+		return nil, builder.NewError(fmt.Sprintf("Multiple context parameters convert %s to %s:\n    %s\n\nOnly one context function per source and target type is allowed.",
+			source.String, target.String, strings.Join(matches, "\n    ")))
+	}
+
+	key := matches[0]
+	call, ok := g.ContextParam(ctx, key)
+	if !ok {
+		return nil, nil
+	}
+	return &method.Definition{
+		ID:         key,
+		OriginID:   key,
+		CustomCall: call,
+		Parameters: method.Parameters{
+			Source:      source,
+			Target:      target,
+			Context:     map[string]*xtype.Type{},
+			Signature:   xtype.SignatureOf(source, target),
+			RawArgs:     []method.Arg{{Use: method.ArgUseSource, Type: source}},
+			ReturnError: returnError[key],
+		},
+	}, nil
+}
+
+// hasTypeParam returns true if the type contains a type parameter, e.g. T or
+// []Item[T].
+func hasTypeParam(t types.Type) bool {
+	switch value := t.(type) {
+	case *types.TypeParam:
+		return true
+	case *types.Pointer:
+		return hasTypeParam(value.Elem())
+	case *types.Slice:
+		return hasTypeParam(value.Elem())
+	case *types.Array:
+		return hasTypeParam(value.Elem())
+	case *types.Map:
+		return hasTypeParam(value.Key()) || hasTypeParam(value.Elem())
+	case *types.Chan:
+		return hasTypeParam(value.Elem())
+	case *types.Named:
+		args := value.TypeArgs()
+		for i := 0; i < args.Len(); i++ {
+			if hasTypeParam(args.At(i)) {
+				return true
+			}
+		}
+	case *types.Struct:
+		for i := 0; i < value.NumFields(); i++ {
+			if hasTypeParam(value.Field(i).Type()) {
+				return true
+			}
+		}
+	case *types.Signature:
+		return hasTypeParam(value.Params()) || hasTypeParam(value.Results())
+	case *types.Tuple:
+		for i := 0; i < value.Len(); i++ {
+			if hasTypeParam(value.At(i).Type()) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// hasRequired returns true if the type contains a struct field matching
+// requireNonZero. Such conversions are built inside the current method, the
+// required checks then use its error handling and report the full path.
+func (g *generator) hasRequired(ctx *builder.MethodContext, t types.Type) bool {
+	if ctx.Conf.RequireNonZero == nil {
+		return false
+	}
+	return g.containsRequired(ctx, t, map[types.Type]struct{}{})
+}
+
+func (g *generator) containsRequired(ctx *builder.MethodContext, t types.Type, seen map[types.Type]struct{}) bool {
+	if _, ok := seen[t]; ok {
+		return false
+	}
+	seen[t] = struct{}{}
+
+	switch value := t.(type) {
+	case *types.Named:
+		return g.containsRequired(ctx, value.Underlying(), seen)
+	case *types.Pointer:
+		return g.containsRequired(ctx, value.Elem(), seen)
+	case *types.Slice:
+		return g.containsRequired(ctx, value.Elem(), seen)
+	case *types.Array:
+		return g.containsRequired(ctx, value.Elem(), seen)
+	case *types.Map:
+		return g.containsRequired(ctx, value.Elem(), seen)
+	case *types.Struct:
+		for i := 0; i < value.NumFields(); i++ {
+			field := value.Field(i)
+			if xtype.Accessible(field, ctx.OutputPackagePath) && ctx.Conf.RequireNonZero.MatchString(field.Name()) {
+				return true
+			}
+			if g.containsRequired(ctx, field.Type(), seen) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// subMethodReason returns why a new method should be created for the
+// conversion, empty if no method should be created.
+func (g *generator) subMethodReason(ctx *builder.MethodContext, source, target *xtype.Type) string {
 	isCurrentPointerStructMethod := false
 	if source.Struct && target.Struct {
 		// This checks if we are currently inside the generation of one of the following combinations.
@@ -514,32 +946,35 @@ func (g *generator) shouldCreateSubMethod(ctx *builder.MethodContext, source, ta
 			ctx.Signature.Target == target.AsPointerType().String()
 	}
 
-	createSubMethod := false
+	reason := ""
 
 	if ctx.HasSeen(source) {
 		g.lookup.ByID(ctx.IndexID).Dirty = true
-		createSubMethod = true
+		reason = "the source type is recursive"
 	} else if !isCurrentPointerStructMethod {
 		switch {
 		case source.Named && !source.Basic:
-			createSubMethod = true
+			reason = "the source type is named"
 		case target.Named && !target.Basic:
-			createSubMethod = true
+			reason = "the target type is named"
 		case source.Pointer && source.PointerInner.Named && !source.PointerInner.Basic:
-			createSubMethod = true
+			reason = "the source type is a pointer to a named type"
 		case source.Enum(&ctx.Conf.Enum).OK && target.Enum(&ctx.Conf.Enum).OK:
-			createSubMethod = true
+			reason = "source and target are enums"
 		}
 		if ctx.Conf.SkipCopySameType && source.String == target.String {
-			createSubMethod = false
+			reason = ""
+		}
+		if g.hasRequired(ctx, target.T) {
+			reason = ""
 		}
 	}
 	ctx.MarkSeen(source)
 
-	return createSubMethod
+	return reason
 }
 
-func (g *generator) createSubMethod(ctx *builder.MethodContext, sourceID *xtype.JenID, source, target *xtype.Type, errPAth builder.ErrorPath) ([]jen.Code, *xtype.JenID, *builder.Error) {
+func (g *generator) createSubMethod(ctx *builder.MethodContext, sourceID *xtype.JenID, source, target *xtype.Type, errPAth builder.ErrorPath, reason string) ([]jen.Code, *xtype.JenID, *builder.Error) {
 	name := g.namer.Name(source.UnescapedID() + "To" + strings.Title(target.UnescapedID()))
 	orig := g.lookup.ByID(ctx.IndexID)
 
@@ -575,10 +1010,14 @@ func (g *generator) createSubMethod(ctx *builder.MethodContext, sourceID *xtype.
 	}
 
 	genMethod.IndexID, _ = g.lookup.Register(genMethod, genMethod.Definition)
+	if g.helperRefs != nil {
+		g.helperRefs[genMethod.Definition] = &jen.Statement{}
+	}
 
 	if err := g.buildMethod(genMethod, ctx.AvailableContext); err != nil {
 		return nil, nil, err
 	}
+	g.trace.decide("new method "+name, reason)
 	return g.CallMethod(ctx, genMethod.Definition, sourceID, source, target, errPAth)
 }
 
@@ -646,11 +1085,27 @@ func (g *generator) qualMethod(m *method.Definition) *jen.Statement {
 	switch {
 	case m.CustomCall != nil:
 		return m.CustomCall.Clone()
+	case g.helperRefs[m] != nil:
+		return jen.Add(g.helperRefs[m])
 	case g.conf.OutputFormat == config.FormatStruct && m.Generated:
 		return jen.Id(xtype.ThisVar).Dot(m.Name)
 	case g.conf.OutputFormat == config.FormatFunction && m.Generated:
-		return jen.Id(m.Name)
+		return jen.Id(m.Name).Add(g.withTypeParams(g.typeParamsUse))
 	default:
 		return jen.Qual(m.Package, m.Name)
 	}
 }
+
+// implType returns the type of the converter struct.
+func (g *generator) implType() *jen.Statement {
+	return jen.Id(g.conf.Name).Add(g.withTypeParams(g.typeParamsUse))
+}
+
+// withTypeParams returns the type parameter list, it's empty for non generic
+// converters.
+func (g *generator) withTypeParams(params []jen.Code) *jen.Statement {
+	if len(params) == 0 {
+		return jen.Null()
+	}
+	return jen.Types(params...)
+}
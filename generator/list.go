@@ -0,0 +1,154 @@
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/jmattheis/goverter/config"
+)
+
+// ConverterInfo describes a converter and the methods goverter generates for
+// it.
+type ConverterInfo struct {
+	Converter     string        `json:"converter"`
+	Location      string        `json:"location"`
+	OutputFile    string        `json:"outputFile"`
+	OutputFormat  string        `json:"outputFormat"`
+	OutputPackage string        `json:"outputPackage"`
+	Methods       []*MethodInfo `json:"methods"`
+	// Helpers are the implicitly generated methods.
+	Helpers []*MethodInfo `json:"helpers"`
+	// Error is set, if the converter couldn't be generated. Helpers is empty
+	// in this case.
+	Error string `json:"error,omitempty"`
+}
+
+// MethodInfo describes a conversion method.
+type MethodInfo struct {
+	Name        string   `json:"name"`
+	Signature   string   `json:"signature"`
+	Location    string   `json:"location,omitempty"`
+	Settings    []string `json:"settings,omitempty"`
+	ReturnError bool     `json:"returnError,omitempty"`
+}
+
+// List describes the converters and the methods that would be generated.
+func List(converters []*config.Converter) []*ConverterInfo {
+	manager := &fileManager{Files: map[string]*managedFile{}}
+	infos := []*ConverterInfo{}
+
+	for _, converter := range converters {
+		info := &ConverterInfo{
+			Converter:     converter.IDString(),
+			Location:      converter.Location,
+			OutputFile:    getOutputDir(converter),
+			OutputFormat:  string(converter.OutputFormat),
+			OutputPackage: converter.PackageID(),
+			Methods:       []*MethodInfo{},
+			Helpers:       []*MethodInfo{},
+		}
+		for _, m := range converter.Methods {
+			info.Methods = append(info.Methods, newMethodInfo(m, m.Location))
+		}
+		infos = append(infos, info)
+
+		f, n, err := manager.Get(converter, Config{})
+		if err == nil {
+			var gen *generator
+			gen, err = setupGenerator(converter, n)
+			if err == nil {
+				err = validateMethods(gen.lookup)
+			}
+			if err == nil {
+				err = gen.buildMethods(f)
+			}
+			if err == nil {
+				for _, genMethod := range gen.getGenMethods() {
+					if !genMethod.Explicit {
+						info.Helpers = append(info.Helpers, newMethodInfo(genMethod.Method, ""))
+					}
+				}
+			}
+		}
+		if err != nil {
+			info.Error = err.Error()
+		}
+	}
+	return infos
+}
+
+func newMethodInfo(m *config.Method, location string) *MethodInfo {
+	params := []string{}
+	for _, arg := range m.RawArgs {
+		params = append(params, arg.Type.String)
+	}
+	returns := m.Target.String
+	if m.UpdateTarget {
+		returns = ""
+	}
+	if m.ReturnError {
+		if returns == "" {
+			returns = "error"
+		} else {
+			returns = "(" + returns + ", error)"
+		}
+	}
+
+	return &MethodInfo{
+		Name:        m.Name,
+		Signature:   strings.TrimSpace(fmt.Sprintf("func(%s) %s", strings.Join(params, ", "), returns)),
+		Location:    location,
+		Settings:    m.RawSettings,
+		ReturnError: m.ReturnError,
+	}
+}
+
+// ListJSON renders the converter infos as indented json.
+func ListJSON(infos []*ConverterInfo) ([]byte, error) {
+	content, err := json.MarshalIndent(map[string]interface{}{"converters": infos}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(content, '\n'), nil
+}
+
+// ListText renders the converter infos as human readable text.
+func ListText(infos []*ConverterInfo) string {
+	var b strings.Builder
+	for i, info := range infos {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "%s\n", info.Converter)
+		fmt.Fprintf(&b, "    location: %s\n", info.Location)
+		fmt.Fprintf(&b, "    output:   %s\n", info.OutputFile)
+		fmt.Fprintf(&b, "    format:   %s\n", info.OutputFormat)
+		fmt.Fprintf(&b, "    package:  %s\n", info.OutputPackage)
+		if len(info.Methods) > 0 {
+			b.WriteString("    methods:\n")
+			for _, m := range info.Methods {
+				fmt.Fprintf(&b, "        %s %s\n", m.Name, m.Signature)
+				for _, setting := range m.Settings {
+					fmt.Fprintf(&b, "            goverter:%s\n", setting)
+				}
+			}
+		}
+		if len(info.Helpers) > 0 {
+			b.WriteString("    helpers:\n")
+			for _, m := range info.Helpers {
+				fmt.Fprintf(&b, "        %s %s\n", m.Name, m.Signature)
+			}
+		}
+		if info.Error != "" {
+			b.WriteString("    error:\n")
+			for _, line := range strings.Split(info.Error, "\n") {
+				if line != "" {
+					b.WriteString("        " + line)
+				}
+				b.WriteString("\n")
+			}
+		}
+	}
+	return b.String()
+}
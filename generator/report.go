@@ -0,0 +1,162 @@
+package generator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"path/filepath"
+	"strings"
+
+	"github.com/jmattheis/goverter/builder"
+	"github.com/jmattheis/goverter/config"
+)
+
+// Report describes how goverter assigns the target fields of all generated
+// struct conversions.
+type Report struct {
+	Converters []*ConverterReport `json:"converters"`
+}
+
+// ConverterReport contains the method reports of a single converter.
+type ConverterReport struct {
+	Converter string                  `json:"converter"`
+	Methods   []*builder.MethodReport `json:"methods"`
+}
+
+func newConverterReport(converter *config.Converter, methods []*generatedMethod) *ConverterReport {
+	c := &ConverterReport{Converter: converter.IDString(), Methods: []*builder.MethodReport{}}
+	for _, m := range methods {
+		if m.Report != nil && (len(m.Report.Fields) > 0 || len(m.Report.Enums) > 0) {
+			c.Methods = append(c.Methods, m.Report)
+		}
+	}
+	return c
+}
+
+// JSON renders the report as indented json.
+func (r *Report) JSON() ([]byte, error) {
+	content, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(content, '\n'), nil
+}
+
+// Docs renders the report as markdown or html depending on the extension of
+// the file.
+func (r *Report) Docs(file string) ([]byte, error) {
+	if filepath.Ext(file) == ".html" {
+		return r.HTML()
+	}
+	return r.Markdown(), nil
+}
+
+// Markdown renders the report as markdown tables.
+func (r *Report) Markdown() []byte {
+	var b bytes.Buffer
+	b.WriteString("# Goverter Mapping Report\n")
+	for _, c := range r.Converters {
+		fmt.Fprintf(&b, "\n## %s\n", c.Converter)
+		for _, m := range c.Methods {
+			fmt.Fprintf(&b, "\n### %s\n\n", m.Name)
+			if m.Source != "" {
+				fmt.Fprintf(&b, "`%s` to `%s`\n\n", m.Source, m.Target)
+			} else {
+				fmt.Fprintf(&b, "`%s`\n\n", m.Target)
+			}
+			if len(m.Fields) > 0 {
+				b.WriteString("| Target | Source | Function | Notes |\n")
+				b.WriteString("| --- | --- | --- | --- |\n")
+				for _, f := range m.Fields {
+					fmt.Fprintf(&b, "| %s | %s | %s | %s |\n",
+						markdownCell(f.Target), markdownCell(f.Source), markdownCell(f.Function), markdownCell(fieldNotes(f)))
+				}
+			}
+			for i, e := range m.Enums {
+				if i > 0 || len(m.Fields) > 0 {
+					b.WriteString("\n")
+				}
+				if e.Path != "" {
+					fmt.Fprintf(&b, "Enum `%s`\n\n", e.Path)
+				}
+				b.WriteString("| Source | Target |\n")
+				b.WriteString("| --- | --- |\n")
+				for _, v := range e.Values {
+					fmt.Fprintf(&b, "| %s | %s |\n", markdownCell(v.Source), markdownCell(v.Target))
+				}
+				fmt.Fprintf(&b, "| *unknown* | %s |\n", markdownCell(e.Unknown))
+			}
+		}
+	}
+	return b.Bytes()
+}
+
+// HTML renders the report as html tables.
+func (r *Report) HTML() ([]byte, error) {
+	var b bytes.Buffer
+	if err := htmlReport.Execute(&b, r); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+var htmlReport = template.Must(template.New("report").Funcs(template.FuncMap{"notes": fieldNotes}).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Goverter Mapping Report</title>
+</head>
+<body>
+<h1>Goverter Mapping Report</h1>
+{{- range .Converters}}
+<h2>{{.Converter}}</h2>
+{{- range .Methods}}
+<h3>{{.Name}}</h3>
+<p>{{if .Source}}<code>{{.Source}}</code> to {{end}}<code>{{.Target}}</code></p>
+{{- if .Fields}}
+<table>
+<tr><th>Target</th><th>Source</th><th>Function</th><th>Notes</th></tr>
+{{- range .Fields}}
+<tr><td>{{.Target}}</td><td>{{.Source}}</td><td>{{.Function}}</td><td>{{notes .}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+{{- range .Enums}}
+{{- if .Path}}
+<p>Enum <code>{{.Path}}</code></p>
+{{- end}}
+<table>
+<tr><th>Source</th><th>Target</th></tr>
+{{- range .Values}}
+<tr><td>{{.Source}}</td><td>{{.Target}}</td></tr>
+{{- end}}
+<tr><td><em>unknown</em></td><td>{{.Unknown}}</td></tr>
+</table>
+{{- end}}
+{{- end}}
+{{- end}}
+</body>
+</html>
+`))
+
+func fieldNotes(f *builder.FieldReport) string {
+	notes := []string{}
+	if f.Skipped != "" {
+		notes = append(notes, "skipped: "+f.Skipped)
+	}
+	if f.Default != "" {
+		notes = append(notes, "default: "+f.Default)
+	}
+	if f.SkipZero {
+		notes = append(notes, "skipped on zero value")
+	}
+	if f.Required {
+		notes = append(notes, "required")
+	}
+	return strings.Join(notes, ", ")
+}
+
+func markdownCell(s string) string {
+	return strings.ReplaceAll(s, "|", "\\|")
+}
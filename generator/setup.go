@@ -4,6 +4,7 @@ import (
 	"github.com/jmattheis/goverter/config"
 	"github.com/jmattheis/goverter/method"
 	"github.com/jmattheis/goverter/namer"
+	"github.com/jmattheis/goverter/xtype"
 )
 
 func setupGenerator(converter *config.Converter, n *namer.Namer) (*generator, error) {
@@ -35,6 +36,11 @@ func setupGenerator(converter *config.Converter, n *namer.Namer) (*generator, er
 		conf:   converter,
 		lookup: lookup,
 		extend: extend,
+
+		usedExtend: map[*method.Definition]struct{}{},
+	}
+	if params := converter.TypeParams(); params != nil {
+		gen.typeParamsDecl, gen.typeParamsUse = xtype.TypeParamsAsJen(params)
 	}
 
 	return &gen, nil
@@ -2,7 +2,11 @@ package generator
 
 import (
 	"fmt"
+	"strings"
 
+	"github.com/jmattheis/goverter/builder"
+	"github.com/jmattheis/goverter/config"
+	"github.com/jmattheis/goverter/diagnostic"
 	"github.com/jmattheis/goverter/method"
 )
 
@@ -14,10 +18,70 @@ func validateMethods(lookup *method.Index[generatedMethod]) error {
 			if genMethod.Explicit && len(genMethod.RawFieldSettings) > 0 {
 				isTargetStructPointer := genMethod.Target.Pointer && genMethod.Parameters.Target.PointerInner.Struct
 				if !genMethod.Target.Struct && !isTargetStructPointer {
-					return fmt.Errorf("Invalid struct field mapping on method:\n    %s\n    %s\n\nField mappings like goverter:map or goverter:ignore may only be set on struct or struct pointers.\nSee https://goverter.jmattheis.de/guide/configure-nested", genMethod.Location, genMethod.ID)
+					cause := "Field mappings like goverter:map or goverter:ignore may only be set on struct or struct pointers.\nSee https://goverter.jmattheis.de/guide/configure-nested"
+					return &diagnostic.Diagnostic{
+						Severity: diagnostic.SeverityError,
+						Message:  fmt.Sprintf("Invalid struct field mapping on method:\n    %s\n    %s\n\n%s", genMethod.Location, genMethod.ID, cause),
+						Location: genMethod.Location,
+						Cause:    cause,
+					}
 				}
 			}
 		}
 	}
 	return nil
 }
+
+func validateIgnorePatterns(converter *config.Converter) error {
+	inherited := map[*config.FieldPattern]struct{}{}
+	for _, pattern := range converter.IgnorePatterns {
+		inherited[pattern] = struct{}{}
+	}
+	for _, pattern := range converter.IgnorePatterns {
+		if !pattern.Global && !pattern.Used {
+			return unusedIgnorePattern("converter", converter.Location, converter.IDString(), pattern)
+		}
+	}
+	for _, m := range converter.Methods {
+		for _, pattern := range m.IgnorePatterns {
+			if _, ok := inherited[pattern]; !ok && !pattern.Used {
+				return unusedIgnorePattern("converter method", m.Location, m.ID, pattern)
+			}
+		}
+	}
+	return nil
+}
+
+func unusedIgnorePattern(kind, location, id string, pattern *config.FieldPattern) error {
+	cause := fmt.Sprintf("The pattern of 'goverter:ignore:pattern %s' does not match any field of the converted target structs.\nRemove or adjust the pattern.", pattern.Raw)
+	return &diagnostic.Diagnostic{
+		Severity: diagnostic.SeverityError,
+		Message:  fmt.Sprintf("Unused ignore pattern in %s:\n    %s\n    %s\n\n%s", kind, location, id, cause),
+		Location: location,
+		Setting:  "goverter:ignore:pattern",
+		Cause:    cause,
+	}
+}
+
+func methodError(genMethod *generatedMethod, err *builder.Error) error {
+	d := &diagnostic.Diagnostic{
+		Severity: diagnostic.SeverityError,
+		Message:  fmt.Sprintf("Error while creating converter method:\n    %s\n    %s%s\n\n%s", genMethod.Location, genMethod.ID, genMethod.Definition.ArgDebug("        "), builder.ToString(err)),
+		Location: genMethod.Location,
+		Cause:    err.Cause,
+		Fix:      err.Fix,
+	}
+	for _, path := range err.Path {
+		if d.Setting == "" && strings.HasPrefix(path.SourceType, "goverter:") {
+			d.Setting = strings.Fields(path.SourceType)[0]
+		}
+		d.Path = append(d.Path, &diagnostic.Path{
+			Prefix:     path.Prefix,
+			SourceID:   path.SourceID,
+			SourceType: path.SourceType,
+			TargetID:   path.TargetID,
+			TargetType: path.TargetType,
+		})
+	}
+	return d
+}
@@ -0,0 +1,78 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jmattheis/goverter/diagnostic"
+	"github.com/jmattheis/goverter/method"
+)
+
+// unusedSettings returns warnings for method and converter settings without
+// an effect on the generated code.
+func (g *generator) unusedSettings() []*diagnostic.Diagnostic {
+	var warnings []*diagnostic.Diagnostic
+	for _, genMethod := range g.getGenMethods() {
+		if !genMethod.Explicit || genMethod.Usage == nil {
+			continue
+		}
+
+		if len(genMethod.RawFieldSettings) > 0 && !genMethod.Usage.Fields {
+			warnings = append(warnings, g.unusedMethodSettings(genMethod, genMethod.RawFieldSettings,
+				fmt.Sprintf("The settings have no effect, because the method doesn't convert into\n    %s\nE.g. because an existing function is used for the conversion.", genMethod.Target.String)))
+		} else if unused := genMethod.Usage.AutoMap.Unused(); len(unused) > 0 {
+			lines := make([]string, 0, len(unused))
+			for _, path := range unused {
+				lines = append(lines, "autoMap "+path)
+			}
+			warnings = append(warnings, g.unusedMethodSettings(genMethod, lines,
+				"The settings have no effect, because no target field is mapped from them."))
+		}
+
+		if len(genMethod.RawEnumSettings) > 0 && !genMethod.Usage.Enum {
+			warnings = append(warnings, g.unusedMethodSettings(genMethod, genMethod.RawEnumSettings,
+				"The settings have no effect, because no enum is converted in this method."))
+		}
+	}
+
+	for _, setting := range g.conf.ExtendSettings {
+		if setting.Global || g.anyExtendUsed(setting.Defs) {
+			continue
+		}
+		cause := fmt.Sprintf("    goverter:%s\n\nThe setting has no effect, because none of its functions are used.", setting.Raw)
+		warnings = append(warnings, &diagnostic.Diagnostic{
+			Severity: diagnostic.SeverityWarning,
+			Message:  fmt.Sprintf("Unused setting in converter:\n    %s\n    %s\n\n%s", g.conf.Location, g.conf.IDString(), cause),
+			Location: g.conf.Location,
+			Setting:  "goverter:extend",
+			Cause:    cause,
+		})
+	}
+	return warnings
+}
+
+func (g *generator) unusedMethodSettings(genMethod *generatedMethod, lines []string, reason string) *diagnostic.Diagnostic {
+	settings := make([]string, 0, len(lines))
+	for _, line := range lines {
+		settings = append(settings, "    goverter:"+line)
+	}
+	cause := strings.Join(settings, "\n") + "\n\n" + reason
+
+	setting, _, _ := strings.Cut(lines[0], " ")
+	return &diagnostic.Diagnostic{
+		Severity: diagnostic.SeverityWarning,
+		Message:  fmt.Sprintf("Unused settings on method:\n    %s\n    %s\n\n%s", genMethod.Location, genMethod.ID, cause),
+		Location: genMethod.Location,
+		Setting:  "goverter:" + setting,
+		Cause:    cause,
+	}
+}
+
+func (g *generator) anyExtendUsed(defs []*method.Definition) bool {
+	for _, def := range defs {
+		if _, ok := g.usedExtend[def]; ok {
+			return true
+		}
+	}
+	return false
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/dave/jennifer/jen"
+	"github.com/jmattheis/goverter/diagnostic"
 	"github.com/jmattheis/goverter/xtype"
 )
 
@@ -59,7 +60,12 @@ func Parse(obj types.Object, opts *ParseOpts, localOpts LocalOpts) (*Definition,
 		if opts.Location != "" {
 			loc = opts.Location + "\n    "
 		}
-		return fmt.Errorf("%s:\n    %s%s%s\n\n%s", opts.ErrorPrefix, loc, obj.String(), methodDef.ArgDebug("        "), s)
+		return &diagnostic.Diagnostic{
+			Severity: diagnostic.SeverityError,
+			Message:  fmt.Sprintf("%s:\n    %s%s%s\n\n%s", opts.ErrorPrefix, loc, obj.String(), methodDef.ArgDebug("        "), s),
+			Location: opts.Location,
+			Cause:    s,
+		}
 	}
 
 	if !xtype.Accessible(obj, opts.OutputPackagePath) {
@@ -100,7 +106,7 @@ func Parse(obj types.Object, opts *ParseOpts, localOpts LocalOpts) (*Definition,
 			default:
 				return nil, formatErr("The signature one non 'error' result or multiple results is not supported for goverter:update signatures.")
 			}
-		case (opts.ContextMatch != nil && opts.ContextMatch.MatchString(arg.Name)) || localOpts.Context[arg.Name]:
+		case (opts.ContextMatch != nil && opts.ContextMatch.MatchString(arg.Name)) || localOpts.Context[arg.Name] || arg.Type.String == xtype.ContextType:
 			methodDef.Context[arg.Type.String] = arg.Type
 			arg.Use = ArgUseContext
 		case methodDef.Source == nil:
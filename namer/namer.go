@@ -65,6 +65,14 @@ func (m *Namer) Register(name string) bool {
 	return false
 }
 
+// Reserve marks the names as used e.g. type parameters, that must not be
+// shadowed by variables.
+func (m *Namer) Reserve(names ...string) {
+	for _, name := range names {
+		m.lookup[name] = struct{}{}
+	}
+}
+
 // Name returns an unused variable name that contains the passed name.
 func (m *Namer) Name(name string) string {
 	for i := 1; ; i++ {
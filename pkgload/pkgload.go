@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"go/ast"
 	"go/types"
+	"path"
+	"path/filepath"
 	"regexp"
 	"strings"
 
@@ -147,6 +149,28 @@ func (g *PackageLoader) GetOneRaw(pkgName, name string) (*packages.Package, type
 	return pkg, obj, nil
 }
 
+// DirPackagePath returns the import path of the directory dir. dir must be
+// inside the module of the loaded package pkgName, but doesn't have to exist.
+func (g *PackageLoader) DirPackagePath(pkgName, dir string) (string, error) {
+	pkg, err := g.getPkg(pkgName)
+	if err != nil {
+		return "", err
+	}
+	if pkg.Module == nil {
+		return "", fmt.Errorf("cannot resolve the package of %s, because %q isn't part of a go module", dir, pkgName)
+	}
+
+	relative, err := filepath.Rel(pkg.Module.Dir, dir)
+	if err != nil {
+		return "", err
+	}
+	relative = filepath.ToSlash(relative)
+	if relative == ".." || strings.HasPrefix(relative, "../") {
+		return "", fmt.Errorf("%s must be inside the module %s located at %s", dir, pkg.Module.Path, pkg.Module.Dir)
+	}
+	return path.Join(pkg.Module.Path, relative), nil
+}
+
 func (g *PackageLoader) GetOne(sourcePackage, fullMethod string, opts *method.ParseOpts) (*method.Definition, error) {
 	pkgName, name, err := ParseMethodString(sourcePackage, fullMethod)
 	if err != nil {
@@ -171,7 +195,7 @@ func (g *PackageLoader) getOneParsed(pkgName, name string, opts *method.ParseOpt
 // loadPackages is used to load extend packages, with caching support.
 func (g *PackageLoader) load(workDir, buildTags string, paths []string) error {
 	packagesCfg := &packages.Config{
-		Mode: packages.NeedName | packages.NeedTypes | packages.NeedTypesInfo | packages.NeedSyntax,
+		Mode: packages.NeedName | packages.NeedTypes | packages.NeedTypesInfo | packages.NeedSyntax | packages.NeedModule,
 		Dir:  workDir,
 	}
 	if buildTags != "" {
@@ -1,13 +1,24 @@
 package goverter
 
 import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
 	"os"
 	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/jmattheis/goverter/comments"
 	"github.com/jmattheis/goverter/config"
+	"github.com/jmattheis/goverter/diagnostic"
 	"github.com/jmattheis/goverter/enum"
 	"github.com/jmattheis/goverter/generator"
+	"github.com/jmattheis/goverter/scaffold"
 )
 
 // GenerateConfig the config for generating a converter.
@@ -22,21 +33,261 @@ type GenerateConfig struct {
 	BuildTags string
 	// OutputBuildConstraint will be added as go:build constraints to all files.
 	OutputBuildConstraint string
+	// OutputHeader is the default output:header of all converters, can be empty.
+	OutputHeader string
 	// EnumTransformers describes additional enum transformers usable in the enum:transform setting.
 	EnumTransformers map[string]enum.Transformer
+	// KeepGoing collects the errors of all converters and methods instead of
+	// returning the first one.
+	KeepGoing bool
+	// Strict turns warnings e.g. about unused settings into errors.
+	Strict bool
+	// Warn is called for each warning, can be nil.
+	Warn func(*diagnostic.Diagnostic)
+	// WritePartial writes the files of converters without errors, if KeepGoing
+	// is enabled.
+	WritePartial bool
+	// Fix adds settings resolving unambiguous errors e.g. missing source
+	// fields to the method comments before generating.
+	Fix bool
+	// Fixed is called for each setting added by Fix, can be nil.
+	Fixed func(*diagnostic.Fix)
+	// Report is the path of the mapping report, the format is inferred from the
+	// file extension (.json or .md). Can be empty.
+	Report string
+	// Clean deletes goverter generated files of the scanned converters that
+	// weren't generated in this run.
+	Clean bool
+	// DryRun reports the file changes without writing or deleting files.
+	DryRun bool
+	// Output receives the generated files instead of the file system, if set.
+	// Files aren't deleted in this case.
+	Output io.Writer
+	// Changed is called for each deleted file and with DryRun for each file
+	// that would be written. With DryRun or Output, stale files are reported as
+	// FileWouldDelete. Can be nil.
+	Changed func(path string, change FileChange)
 }
 
+// FileChange describes the change of a file in the working tree.
+type FileChange string
+
+const (
+	// FileNew is a file that doesn't exist yet.
+	FileNew FileChange = "new"
+	// FileChanged is an existing file with different content.
+	FileChanged FileChange = "changed"
+	// FileUnchanged is an existing file with the same content.
+	FileUnchanged FileChange = "unchanged"
+	// FileDeleted is a stale goverter generated file deleted by Clean.
+	FileDeleted FileChange = "deleted"
+	// FileWouldDelete is a stale goverter generated file that Clean would
+	// delete, but the files aren't modified because of DryRun or Output.
+	FileWouldDelete FileChange = "would delete"
+)
+
 // GenerateConverters generates converters.
 func GenerateConverters(c *GenerateConfig) error {
-	files, err := generateConvertersRaw(c)
-	if err != nil {
+	if c.Fix {
+		if err := fixConverters(c); err != nil {
+			return err
+		}
+	}
+
+	files, converters, err := generateConvertersRaw(c)
+	if err != nil && !(c.KeepGoing && c.WritePartial) {
 		return err
 	}
 
-	return writeFiles(files)
+	var stale []string
+	if c.Clean && err == nil {
+		var staleErr error
+		if stale, staleErr = staleFiles(converters, files); staleErr != nil {
+			return staleErr
+		}
+	}
+
+	modify := !c.DryRun && c.Output == nil
+	switch {
+	case c.Output != nil:
+		if writeErr := printFiles(c.Output, files); writeErr != nil {
+			return writeErr
+		}
+	case c.DryRun:
+		if c.Changed != nil {
+			for _, file := range sortedKeys(files) {
+				c.Changed(file, fileChange(file, files[file]))
+			}
+		}
+	default:
+		if writeErr := writeFiles(files); writeErr != nil {
+			return writeErr
+		}
+	}
+	for _, file := range stale {
+		change := FileWouldDelete
+		if modify {
+			if removeErr := os.Remove(file); removeErr != nil {
+				return removeErr
+			}
+			change = FileDeleted
+		}
+		if c.Changed != nil {
+			c.Changed(file, change)
+		}
+	}
+	return err
+}
+
+// maxFixPasses limits the generation runs of fixConverters.
+const maxFixPasses = 20
+
+// fixConverters inserts the fixes of the generation errors into the method
+// comments until no new fixes are found. Multiple runs are required, because
+// only the first error of a method is reported. Fixing stops if a fix is
+// reported again after it was inserted, or after maxFixPasses runs. The
+// remaining errors are reported by the following generation.
+func fixConverters(c *GenerateConfig) error {
+	conf := *c
+	conf.KeepGoing = true
+	conf.Strict = false
+	conf.Report = ""
+	conf.Warn = nil
+
+	applied := map[diagnostic.Fix]struct{}{}
+	for pass := 0; pass < maxFixPasses; pass++ {
+		_, _, err := generateConvertersRaw(&conf)
+
+		fixes := []*diagnostic.Fix{}
+		seen := map[diagnostic.Fix]struct{}{}
+		for _, d := range diagnostic.FromError(err) {
+			if d.Fix == nil {
+				continue
+			}
+			if _, ok := applied[*d.Fix]; ok {
+				// the inserted setting didn't resolve the error.
+				return nil
+			}
+			if _, ok := seen[*d.Fix]; ok {
+				continue
+			}
+			seen[*d.Fix] = struct{}{}
+			fixes = append(fixes, d.Fix)
+		}
+		if len(fixes) == 0 {
+			return nil
+		}
+
+		if err := comments.InsertFixes(fixes); err != nil {
+			return err
+		}
+		for _, fix := range fixes {
+			applied[*fix] = struct{}{}
+		}
+		applied = shiftFixes(applied, fixes)
+		if c.Fixed != nil {
+			for _, fix := range fixes {
+				c.Fixed(fix)
+			}
+		}
+	}
+	return nil
+}
+
+// shiftFixes moves the locations of the fixes below the inserted settings,
+// so that they point to the methods after the insertion.
+func shiftFixes(fixes map[diagnostic.Fix]struct{}, inserted []*diagnostic.Fix) map[diagnostic.Fix]struct{} {
+	shifted := make(map[diagnostic.Fix]struct{}, len(fixes))
+	for fix := range fixes {
+		file, line, ok := splitLocation(fix.Location)
+		if ok {
+			offset := 0
+			for _, ins := range inserted {
+				if insFile, insLine, ok := splitLocation(ins.Location); ok && insFile == file && insLine <= line {
+					offset++
+				}
+			}
+			fix.Location = file + ":" + strconv.Itoa(line+offset)
+		}
+		shifted[fix] = struct{}{}
+	}
+	return shifted
+}
+
+func splitLocation(location string) (string, int, bool) {
+	idx := strings.LastIndex(location, ":")
+	if idx == -1 {
+		return "", 0, false
+	}
+	line, err := strconv.Atoi(location[idx+1:])
+	return location[:idx], line, err == nil
+}
+
+// CheckConverters validates converters without writing files.
+func CheckConverters(c *GenerateConfig) error {
+	_, _, err := generateConvertersRaw(c)
+	return err
+}
+
+// InitConverter creates a converter interface file and returns its path.
+func InitConverter(c *scaffold.Config) (string, error) {
+	file, content, err := scaffold.Create(c)
+	if err != nil {
+		return "", err
+	}
+	return file, writeFiles(map[string][]byte{file: content})
+}
+
+// ListConverters describes all converters and the methods that would be
+// generated.
+func ListConverters(c *GenerateConfig) ([]*generator.ConverterInfo, error) {
+	converters, err := parseConverters(c)
+	if err != nil {
+		return nil, err
+	}
+	return generator.List(converters), nil
 }
 
-func generateConvertersRaw(c *GenerateConfig) (map[string][]byte, error) {
+// ExplainMethod explains how the method is built. The method is referenced
+// as Interface.Method, the interface can be prefixed with its package path.
+func ExplainMethod(c *GenerateConfig, name string) (*generator.Explanation, error) {
+	idx := strings.LastIndex(name, ".")
+	if idx == -1 {
+		return nil, fmt.Errorf("invalid method %q, expected Interface.Method", name)
+	}
+	iface, methodName := name[:idx], name[idx+1:]
+
+	converters, err := parseConverters(c)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := []*config.Converter{}
+	for _, converter := range converters {
+		id := converter.IDString()
+		if id == iface {
+			return generator.Explain(converter, methodName)
+		}
+		if strings.HasSuffix(id, "."+iface) {
+			matches = append(matches, converter)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("converter %s does not exist", iface)
+	case 1:
+		return generator.Explain(matches[0], methodName)
+	default:
+		ids := make([]string, 0, len(matches))
+		for _, converter := range matches {
+			ids = append(ids, "    "+converter.IDString())
+		}
+		return nil, fmt.Errorf("converter %s is ambiguous, use the full package path of one of:\n%s", iface, strings.Join(ids, "\n"))
+	}
+}
+
+func parseConverters(c *GenerateConfig) ([]*config.Converter, error) {
 	rawConverters, err := comments.ParseDocs(comments.ParseDocsConfig{
 		BuildTags:      c.BuildTags,
 		PackagePattern: c.PackagePatterns,
@@ -46,23 +297,276 @@ func generateConvertersRaw(c *GenerateConfig) (map[string][]byte, error) {
 		return nil, err
 	}
 
-	converters, err := config.Parse(&config.Raw{
+	return config.Parse(&config.Raw{
 		BuildTags:  c.BuildTags,
 		WorkDir:    c.WorkingDir,
 		Converters: rawConverters,
 		Global:     c.Global,
 
 		OuputBuildConstraint: c.OutputBuildConstraint,
+		OutputHeader:         c.OutputHeader,
 
 		EnumTransformers: c.EnumTransformers,
+
+		KeepGoing: c.KeepGoing,
 	})
+}
+
+func generateConvertersRaw(c *GenerateConfig) (map[string][]byte, []*config.Converter, error) {
+	converters, err := parseConverters(c)
+	errs := []error{}
 	if err != nil {
-		return nil, err
+		if !c.KeepGoing {
+			return nil, nil, err
+		}
+		errs = append(errs, err)
+	}
+
+	var report *generator.Report
+	if c.Report != "" {
+		report = &generator.Report{}
 	}
 
-	return generator.Generate(converters, generator.Config{
+	files, err := generator.Generate(converters, generator.Config{
 		BuildConstraint: c.OutputBuildConstraint,
+		Report:          report,
+		KeepGoing:       c.KeepGoing,
+		Strict:          c.Strict,
+		Warn:            c.Warn,
 	})
+	if err != nil {
+		if !c.KeepGoing {
+			return nil, nil, err
+		}
+		errs = append(errs, err)
+	}
+	if len(errs) > 0 {
+		return files, converters, diagnostic.Join(errs...)
+	}
+
+	if report != nil {
+		if err := addReport(files, c, report); err != nil {
+			return nil, nil, err
+		}
+	}
+	return files, converters, nil
+}
+
+func addReport(files map[string][]byte, c *GenerateConfig, report *generator.Report) error {
+	path := c.Report
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(c.WorkingDir, path)
+	}
+
+	switch filepath.Ext(path) {
+	case ".json":
+		content, err := report.JSON()
+		if err != nil {
+			return err
+		}
+		files[path] = content
+	case ".md":
+		files[path] = report.Markdown()
+	default:
+		return fmt.Errorf("unsupported report format %q, the report file must end with .json or .md", c.Report)
+	}
+	return nil
+}
+
+// staleFiles returns the goverter generated files that weren't generated in
+// this run and belong to one of the converters. The files are only searched
+// in the output directories of this run and in the possible previous output
+// locations of the converters: the default ./generated directory and the
+// package of the converter. Other directories aren't searched, they may
+// contain files of other goverter runs.
+//
+// A file belongs to a converter, if it's in the package of the converter or
+// imports it. Files outside of the current output directories must
+// additionally declare the implementation of the converter, to not delete
+// files generated by other goverter runs.
+func staleFiles(converters []*config.Converter, files map[string][]byte) ([]string, error) {
+	outputDirs := map[string]struct{}{}
+	for path := range files {
+		if filepath.Ext(path) == ".go" {
+			outputDirs[filepath.Dir(path)] = struct{}{}
+		}
+	}
+
+	candidates := map[string]struct{}{}
+	addDir := func(dir string) error {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		for _, entry := range entries {
+			path := filepath.Join(dir, entry.Name())
+			if _, ok := files[path]; !ok && !entry.IsDir() && filepath.Ext(path) == ".go" {
+				candidates[path] = struct{}{}
+			}
+		}
+		return nil
+	}
+
+	for dir := range outputDirs {
+		if err := addDir(dir); err != nil {
+			return nil, err
+		}
+	}
+	// the default output directory and the package of the converter are the
+	// possible previous output locations.
+	for _, converter := range converters {
+		dir := filepath.Dir(converter.FileName)
+		for _, previous := range []string{dir, filepath.Join(dir, "generated")} {
+			if err := addDir(previous); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	stale := []string{}
+	for path := range candidates {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if !generator.IsGenerated(content) {
+			continue
+		}
+		_, inOutputDir := outputDirs[filepath.Dir(path)]
+		if belongsToConverter(path, content, converters, inOutputDir) {
+			stale = append(stale, path)
+		}
+	}
+	sort.Strings(stale)
+	return stale, nil
+}
+
+func belongsToConverter(path string, content []byte, converters []*config.Converter, inOutputDir bool) bool {
+	file, err := parser.ParseFile(token.NewFileSet(), path, content, parser.SkipObjectResolution)
+	if err != nil {
+		return false
+	}
+
+	imports := map[string]struct{}{}
+	for _, spec := range file.Imports {
+		if value, err := strconv.Unquote(spec.Path.Value); err == nil {
+			imports[value] = struct{}{}
+		}
+	}
+	declared := declaredNames(file)
+
+	for _, converter := range converters {
+		_, imported := imports[converter.Package]
+		if !imported && filepath.Dir(path) != filepath.Dir(converter.FileName) {
+			continue
+		}
+		if inOutputDir {
+			return true
+		}
+		for _, name := range implementationNames(converter) {
+			if _, ok := declared[name]; ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// declaredNames returns the names of the top level declarations and the
+// receiver types of the methods in file.
+func declaredNames(file *ast.File) map[string]struct{} {
+	names := map[string]struct{}{}
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			if decl.Recv == nil || len(decl.Recv.List) == 0 {
+				names[decl.Name.Name] = struct{}{}
+				continue
+			}
+			expr := decl.Recv.List[0].Type
+			if star, ok := expr.(*ast.StarExpr); ok {
+				expr = star.X
+			}
+			if index, ok := expr.(*ast.IndexExpr); ok {
+				expr = index.X
+			}
+			if index, ok := expr.(*ast.IndexListExpr); ok {
+				expr = index.X
+			}
+			if ident, ok := expr.(*ast.Ident); ok {
+				names[ident.Name] = struct{}{}
+			}
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				switch spec := spec.(type) {
+				case *ast.TypeSpec:
+					names[spec.Name.Name] = struct{}{}
+				case *ast.ValueSpec:
+					for _, name := range spec.Names {
+						names[name.Name] = struct{}{}
+					}
+				}
+			}
+		}
+	}
+	return names
+}
+
+// implementationNames returns the names declared by the generated
+// implementation of the converter.
+func implementationNames(c *config.Converter) []string {
+	if c.OutputFormat == config.FormatStruct {
+		return []string{c.Name}
+	}
+	names := []string{}
+	for _, m := range c.Methods {
+		names = append(names, m.Definition.Name)
+	}
+	return names
+}
+
+// fileChange returns how writing content to path changes the file.
+func fileChange(path string, content []byte) FileChange {
+	existing, err := os.ReadFile(path)
+	switch {
+	case err != nil:
+		return FileNew
+	case bytes.Equal(existing, content):
+		return FileUnchanged
+	default:
+		return FileChanged
+	}
+}
+
+// printFiles writes the files sorted by path to w, each file is preceded by
+// a comment containing its path.
+func printFiles(w io.Writer, files map[string][]byte) error {
+	for i, path := range sortedKeys(files) {
+		if i > 0 {
+			if _, err := io.WriteString(w, "\n"); err != nil {
+				return err
+			}
+		}
+		if _, err := fmt.Fprintf(w, "// File: %s\n", path); err != nil {
+			return err
+		}
+		if _, err := w.Write(files[path]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func sortedKeys(files map[string][]byte) []string {
+	keys := make([]string, 0, len(files))
+	for key := range files {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
 }
 
 func writeFiles(files map[string][]byte) error {
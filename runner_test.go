@@ -2,6 +2,7 @@ package goverter
 
 import (
 	"fmt"
+	"io/fs"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -11,6 +12,9 @@ import (
 	"testing"
 
 	"github.com/jmattheis/goverter/config"
+	"github.com/jmattheis/goverter/diagnostic"
+	"github.com/jmattheis/goverter/generator"
+	"github.com/jmattheis/goverter/scaffold"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/yaml.v3"
@@ -72,23 +76,79 @@ func TestScenario(t *testing.T) {
 				patterns = append(patterns, "github.com/jmattheis/goverter/execution")
 			}
 
-			files, err := generateConvertersRaw(
-				&GenerateConfig{
-					WorkingDir:            testWorkDir,
-					PackagePatterns:       patterns,
-					OutputBuildConstraint: scenario.BuildConstraint,
-					BuildTags:             "goverter",
-					Global: config.RawLines{
-						Lines:    scenario.Global,
-						Location: "scenario global",
-					},
+			if scenario.Init != nil {
+				file, err := InitConverter(&scaffold.Config{
+					Source:     scenario.Init.Source,
+					Target:     scenario.Init.Target,
+					OutputDir:  scenario.Init.Out,
+					WorkingDir: testWorkDir,
 				})
+				require.NoError(t, err)
+				content, err := os.ReadFile(file)
+				require.NoError(t, err)
+				initialized := toOutputFiles(testWorkDir, map[string][]byte{file: content})[0]
+				if UpdateScenario {
+					scenario.Initialized = initialized
+				}
+				require.Equal(t, scenario.Initialized, initialized)
+			}
+
+			if scenario.Explain != "" || scenario.List {
+				testCommandOutput(t, &scenario, scenarioFilePath, testWorkDir, patterns)
+				return
+			}
+			if scenario.DryRun || scenario.Stdout {
+				testGenerateOutput(t, &scenario, scenarioFilePath, testWorkDir, patterns)
+				return
+			}
+
+			var warnings []string
+			var fixed []string
+			c := &GenerateConfig{
+				WorkingDir:            testWorkDir,
+				PackagePatterns:       patterns,
+				OutputBuildConstraint: scenario.BuildConstraint,
+				OutputHeader:          scenario.Header,
+				BuildTags:             "goverter",
+				Report:                scenario.Report,
+				KeepGoing:             scenario.KeepGoing,
+				Strict:                scenario.Strict,
+				Warn: func(d *diagnostic.Diagnostic) {
+					warnings = append(warnings, replaceAbsolutePath(testWorkDir, d.Message))
+				},
+				Fixed: func(fix *diagnostic.Fix) {
+					fixed = append(fixed, replaceAbsolutePath(testWorkDir, fix.Location+": "+fix.Setting))
+				},
+				Global: config.RawLines{
+					Lines:    scenario.Global,
+					Location: "scenario global",
+				},
+			}
+			if scenario.Fix {
+				require.NoError(t, fixConverters(c))
+			}
+			files, converters, err := generateConvertersRaw(c)
 
 			actualOutputFiles := toOutputFiles(testWorkDir, files)
 
+			var deleted []string
+			if scenario.Clean && err == nil {
+				stale, staleErr := staleFiles(converters, files)
+				require.NoError(t, staleErr)
+				for _, file := range stale {
+					deleted = append(deleted, replaceAbsolutePath(testWorkDir, file))
+				}
+			}
+
 			if UpdateScenario {
+				scenario.Warnings = warnings
+				scenario.Fixed = fixed
+				scenario.Deleted = deleted
 				if err != nil {
 					scenario.Success = []*OutputFile{}
+					if scenario.KeepGoing {
+						scenario.Success = actualOutputFiles
+					}
 					scenario.Error = replaceAbsolutePath(testWorkDir, fmt.Sprint(err))
 				} else {
 					scenario.Success = toOutputFiles(testWorkDir, files)
@@ -100,9 +160,16 @@ func TestScenario(t *testing.T) {
 				}
 			}
 
+			require.Equal(t, scenario.Warnings, warnings)
+			require.Equal(t, scenario.Fixed, fixed)
+			require.Equal(t, scenario.Deleted, deleted)
+
 			if scenario.Error != "" {
 				require.Error(t, err)
 				require.Equal(t, scenario.Error, replaceAbsolutePath(testWorkDir, fmt.Sprint(err)))
+				if scenario.KeepGoing {
+					require.ElementsMatch(t, scenario.Success, actualOutputFiles)
+				}
 				return
 			}
 
@@ -112,11 +179,114 @@ func TestScenario(t *testing.T) {
 
 			err = writeFiles(files)
 			require.NoError(t, err)
+			for _, file := range deleted {
+				require.NoError(t, os.Remove(strings.Replace(file, "@workdir", testWorkDir, 1)))
+			}
 			require.NoError(t, compile(testWorkDir), "generated converter doesn't build")
 		})
 	}
 }
 
+// testCommandOutput tests the text output of goverter explain and list.
+func testCommandOutput(t *testing.T, scenario *Scenario, scenarioFilePath, testWorkDir string, patterns []string) {
+	c := &GenerateConfig{
+		WorkingDir:      testWorkDir,
+		PackagePatterns: patterns,
+		BuildTags:       "goverter",
+		Global: config.RawLines{
+			Lines:    scenario.Global,
+			Location: "scenario global",
+		},
+	}
+
+	output := ""
+	var err error
+	if scenario.List {
+		var infos []*generator.ConverterInfo
+		if infos, err = ListConverters(c); err == nil {
+			output = generator.ListText(infos)
+		}
+	} else {
+		var explanation *generator.Explanation
+		if explanation, err = ExplainMethod(c, scenario.Explain); err == nil {
+			output = explanation.Text()
+		}
+	}
+	actual := replaceAbsolutePath(testWorkDir, output)
+
+	if UpdateScenario {
+		scenario.Output = actual
+		scenario.Error = ""
+		if err != nil {
+			scenario.Error = replaceAbsolutePath(testWorkDir, fmt.Sprint(err))
+		}
+		newBytes, err := yaml.Marshal(scenario)
+		if assert.NoError(t, err) {
+			os.WriteFile(scenarioFilePath, newBytes, 0o644)
+		}
+	}
+
+	if scenario.Error != "" {
+		require.Error(t, err)
+		require.Equal(t, scenario.Error, replaceAbsolutePath(testWorkDir, fmt.Sprint(err)))
+		return
+	}
+	require.NoError(t, err)
+	require.Equal(t, scenario.Output, actual)
+}
+
+// testGenerateOutput tests goverter gen with -dry-run or -stdout, the files
+// in the working directory must stay unchanged.
+func testGenerateOutput(t *testing.T, scenario *Scenario, scenarioFilePath, testWorkDir string, patterns []string) {
+	var out strings.Builder
+	var changes []string
+	c := &GenerateConfig{
+		WorkingDir:      testWorkDir,
+		PackagePatterns: patterns,
+		BuildTags:       "goverter",
+		Clean:           scenario.Clean,
+		DryRun:          scenario.DryRun,
+		Changed: func(path string, change FileChange) {
+			changes = append(changes, replaceAbsolutePath(testWorkDir, string(change)+" "+path))
+		},
+		Global: config.RawLines{
+			Lines:    scenario.Global,
+			Location: "scenario global",
+		},
+	}
+	if scenario.Stdout {
+		c.Output = &out
+	}
+	err := GenerateConverters(c)
+	require.NoError(t, err)
+	actual := replaceAbsolutePath(testWorkDir, out.String())
+
+	if UpdateScenario {
+		scenario.Output = actual
+		scenario.Changes = changes
+		newBytes, err := yaml.Marshal(scenario)
+		if assert.NoError(t, err) {
+			os.WriteFile(scenarioFilePath, newBytes, 0o644)
+		}
+	}
+
+	require.Equal(t, scenario.Output, actual)
+	require.Equal(t, scenario.Changes, changes)
+
+	files := map[string]string{}
+	err = filepath.WalkDir(testWorkDir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() || entry.Name() == "go.mod" {
+			return err
+		}
+		content, err := os.ReadFile(path)
+		rel, _ := filepath.Rel(testWorkDir, path)
+		files[filepath.ToSlash(rel)] = string(content)
+		return err
+	})
+	require.NoError(t, err)
+	require.Equal(t, scenario.Input, files, "the files must not be modified")
+}
+
 func replaceAbsolutePath(curPath, body string) string {
 	return filepath.ToSlash(strings.ReplaceAll(body, curPath, "@workdir"))
 }
@@ -155,13 +325,46 @@ type Scenario struct {
 	Global []string          `yaml:"global,omitempty"`
 
 	BuildConstraint string `yaml:"build_constraint,omitempty"`
+	Header          string `yaml:"header,omitempty"`
+	Report          string `yaml:"report,omitempty"`
+	KeepGoing       bool   `yaml:"keep_going,omitempty"`
+	Strict          bool   `yaml:"strict,omitempty"`
+	Explain         string `yaml:"explain,omitempty"`
+	List            bool   `yaml:"list,omitempty"`
+	Fix             bool   `yaml:"fix,omitempty"`
+	Clean           bool   `yaml:"clean,omitempty"`
+	DryRun          bool   `yaml:"dry_run,omitempty"`
+	Stdout          bool   `yaml:"stdout,omitempty"`
+
+	// Init creates a converter with goverter init before generating.
+	Init *ScenarioInit `yaml:"init,omitempty"`
+	// Initialized is the converter created by Init.
+	Initialized *OutputFile `yaml:"initialized,omitempty"`
 
 	Patterns []string      `yaml:"patterns,omitempty"`
 	Success  []*OutputFile `yaml:"success,omitempty"`
 
+	Warnings []string `yaml:"warnings,omitempty"`
+	// Fixed are the settings added with fix.
+	Fixed []string `yaml:"fixed,omitempty"`
+	// Deleted are the stale files deleted with clean.
+	Deleted []string `yaml:"deleted,omitempty"`
+
+	// Changes are the reported file changes with dry_run or stdout.
+	Changes []string `yaml:"changes,omitempty"`
+
+	// Output is the text output of explain, list or stdout.
+	Output string `yaml:"output,omitempty"`
+
 	Error string `yaml:"error,omitempty"`
 }
 
+type ScenarioInit struct {
+	Source string `yaml:"source"`
+	Target string `yaml:"target"`
+	Out    string `yaml:"out"`
+}
+
 type OutputFile struct {
 	Name    string
 	Content string
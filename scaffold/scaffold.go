@@ -0,0 +1,180 @@
+// Package scaffold creates converter interfaces for goverter init.
+package scaffold
+
+import (
+	"bytes"
+	"fmt"
+	"go/types"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/jmattheis/goverter/pkgload"
+	"github.com/jmattheis/goverter/xtype"
+)
+
+// FileName is the name of the created converter file.
+const FileName = "converter.go"
+
+// Config the config for creating a converter interface.
+type Config struct {
+	// Source is the source type of the conversion method e.g.
+	// github.com/example/pkg.Input, required.
+	Source string
+	// Target is the target type of the conversion method, required.
+	Target string
+	// OutputDir is the directory of the created file, relative to WorkingDir.
+	OutputDir string
+	// WorkingDir is the working directory, can be empty.
+	WorkingDir string
+	// BuildTags is a comma separated list passed to -tags when loading the
+	// packages.
+	BuildTags string
+}
+
+// Create returns the path and content of a converter interface converting
+// Source into Target.
+func Create(c *Config) (string, []byte, error) {
+	sourcePkg, sourceName, err := splitType(c.Source)
+	if err != nil {
+		return "", nil, err
+	}
+	targetPkg, targetName, err := splitType(c.Target)
+	if err != nil {
+		return "", nil, err
+	}
+
+	loader, err := pkgload.New(c.WorkingDir, c.BuildTags, []string{sourcePkg, targetPkg})
+	if err != nil {
+		return "", nil, err
+	}
+	source, err := loadStruct(loader, sourcePkg, sourceName)
+	if err != nil {
+		return "", nil, err
+	}
+	target, err := loadStruct(loader, targetPkg, targetName)
+	if err != nil {
+		return "", nil, err
+	}
+
+	outputDir := c.OutputDir
+	if !filepath.IsAbs(outputDir) {
+		outputDir = filepath.Join(c.WorkingDir, outputDir)
+	}
+	outputDir, err = filepath.Abs(outputDir)
+	if err != nil {
+		return "", nil, err
+	}
+	outputPkg, err := loader.DirPackagePath(sourcePkg, outputDir)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var f *jen.File
+	switch outputPkg {
+	case sourcePkg:
+		f = jen.NewFilePathName(outputPkg, source.NamedType.Obj().Pkg().Name())
+	case targetPkg:
+		f = jen.NewFilePathName(outputPkg, target.NamedType.Obj().Pkg().Name())
+	default:
+		f = jen.NewFilePathName(outputPkg, packageName(outputDir))
+	}
+
+	settings := []jen.Code{}
+	for _, line := range fieldSettings(source, target) {
+		settings = append(settings, jen.Comment("goverter:"+line))
+	}
+
+	f.Comment("goverter:converter")
+	f.Type().Id("Converter").Interface(append(settings,
+		jen.Id("Convert").Params(jen.Id("source").Add(source.TypeAsJen())).Add(target.TypeAsJen()))...)
+
+	var buf bytes.Buffer
+	if err := f.Render(&buf); err != nil {
+		return "", nil, err
+	}
+
+	file := filepath.Join(outputDir, FileName)
+	if _, err := os.Stat(file); err == nil {
+		return "", nil, fmt.Errorf("%s already exists", file)
+	}
+	return file, buf.Bytes(), nil
+}
+
+// fieldSettings returns map settings for target fields with a similar named
+// source field and ignore settings for the remaining unmatched target fields.
+func fieldSettings(source, target *xtype.Type) []string {
+	var lines []string
+	for i := 0; i < target.StructType.NumFields(); i++ {
+		field := target.StructType.Field(i)
+		if !field.Exported() {
+			lines = append(lines, "ignore "+field.Name())
+			continue
+		}
+
+		_, err := xtype.FindField(field.Name(), false, source, nil)
+		if noMatch, ok := err.(*xtype.NoMatchError); ok {
+			if suggestion := compatibleField(source, noMatch.Suggestions, field.Type()); suggestion != "" {
+				lines = append(lines, fmt.Sprintf("map %s %s", suggestion, field.Name()))
+			} else {
+				lines = append(lines, "ignore "+field.Name())
+			}
+		}
+	}
+	return lines
+}
+
+// compatibleField returns the first suggested source field that is
+// assignable to the target type or has the same underlying type, or an empty
+// string.
+func compatibleField(source *xtype.Type, suggestions []string, target types.Type) string {
+	for _, name := range suggestions {
+		match, err := xtype.FindField(name, false, source, nil)
+		if err != nil {
+			continue
+		}
+		sourceType := match.Type.T
+		if types.AssignableTo(sourceType, target) || types.Identical(sourceType.Underlying(), target.Underlying()) {
+			return name
+		}
+	}
+	return ""
+}
+
+func splitType(fullType string) (string, string, error) {
+	idx := strings.LastIndex(fullType, ".")
+	if idx <= 0 || idx == len(fullType)-1 {
+		return "", "", fmt.Errorf("invalid type %q, expected the package path and type name e.g. github.com/example/pkg.Input", fullType)
+	}
+	return fullType[:idx], fullType[idx+1:], nil
+}
+
+func loadStruct(loader *pkgload.PackageLoader, pkgName, name string) (*xtype.Type, error) {
+	_, obj, err := loader.GetOneRaw(pkgName, name)
+	if err != nil {
+		return nil, err
+	}
+	t := xtype.TypeOf(obj.Type())
+	if !t.Named || !t.Struct {
+		return nil, fmt.Errorf("%s.%s must be a named struct type", pkgName, name)
+	}
+	return t, nil
+}
+
+func packageName(dir string) string {
+	name := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '_':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return r - 'A' + 'a'
+		default:
+			return -1
+		}
+	}, filepath.Base(dir))
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		return "converter"
+	}
+	return name
+}
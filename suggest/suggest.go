@@ -0,0 +1,97 @@
+// Package suggest finds similar names for "did you mean" hints in errors.
+package suggest
+
+import (
+	"sort"
+	"strings"
+)
+
+// maxSuggestions is the maximum amount of returned suggestions.
+const maxSuggestions = 3
+
+// Similar returns the candidates that are similar to name, the most similar
+// first. A candidate is similar if it equals name case-insensitively or if the
+// edit distance is small compared to the length of name.
+func Similar(name string, candidates []string) []string {
+	type match struct {
+		name     string
+		distance int
+	}
+
+	lower := strings.ToLower(name)
+	maxDistance := len(name) / 3
+	if maxDistance < 1 {
+		maxDistance = 1
+	}
+
+	seen := map[string]struct{}{}
+	var matches []match
+	for _, candidate := range candidates {
+		if candidate == name {
+			continue
+		}
+		if _, ok := seen[candidate]; ok {
+			continue
+		}
+		seen[candidate] = struct{}{}
+
+		distance := editDistance(lower, strings.ToLower(candidate))
+		if distance <= maxDistance {
+			matches = append(matches, match{name: candidate, distance: distance})
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].distance != matches[j].distance {
+			return matches[i].distance < matches[j].distance
+		}
+		return matches[i].name < matches[j].name
+	})
+
+	result := []string{}
+	for i := 0; i < len(matches) && i < maxSuggestions; i++ {
+		result = append(result, matches[i].name)
+	}
+	return result
+}
+
+// Hint formats the suggestions as sentence. It returns an empty string if
+// there are no suggestions.
+func Hint(suggestions []string) string {
+	switch len(suggestions) {
+	case 0:
+		return ""
+	case 1:
+		return "Did you mean " + suggestions[0] + "?"
+	default:
+		return "Did you mean one of " + strings.Join(suggestions, ", ") + "?"
+	}
+}
+
+// editDistance returns the optimal string alignment distance of a and b. It's
+// the levenshtein distance extended by transpositions of adjacent characters.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev2 := make([]int, len(rb)+1)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				cur[j] = min(cur[j], prev2[j-2]+1)
+			}
+		}
+		prev2, prev, cur = prev, cur, prev2
+	}
+	return prev[len(rb)]
+}
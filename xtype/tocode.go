@@ -30,10 +30,49 @@ func toCode(t types.Type) *jen.Statement {
 		return jen.Func().Add(toCodeSignature(cast))
 	case *types.Chan:
 		return toChan(cast)
+	case *types.TypeParam:
+		return jen.Id(cast.Obj().Name())
+	case *types.Union:
+		return toCodeUnion(cast)
 	}
 	panic("unsupported type " + t.String())
 }
 
+func toCodeUnion(t *types.Union) *jen.Statement {
+	stmt := jen.Null()
+	for i := 0; i < t.Len(); i++ {
+		if i > 0 {
+			stmt.Op("|")
+		}
+		term := t.Term(i)
+		if term.Tilde() {
+			stmt.Op("~")
+		}
+		stmt.Add(toCode(term.Type()))
+	}
+	return stmt
+}
+
+// TypeParamsAsJen returns the declaration e.g. [T any, U comparable] and
+// the usage e.g. [T, U] of the type parameters.
+func TypeParamsAsJen(list *types.TypeParamList) (decl, use []jen.Code) {
+	for i := 0; i < list.Len(); i++ {
+		param := list.At(i)
+		constraint := param.Constraint()
+		var constraintCode jen.Code
+		if iface, ok := types.Unalias(constraint).(*types.Interface); ok && iface.Empty() {
+			constraintCode = jen.Any()
+		} else if iface, ok := constraint.(*types.Interface); ok && iface.IsImplicit() {
+			constraintCode = toCode(iface.EmbeddedType(0))
+		} else {
+			constraintCode = toCode(constraint)
+		}
+		decl = append(decl, jen.Id(param.Obj().Name()).Add(constraintCode))
+		use = append(use, jen.Id(param.Obj().Name()))
+	}
+	return decl, use
+}
+
 func toChan(t *types.Chan) *jen.Statement {
 	switch t.Dir() {
 	case types.SendRecv:
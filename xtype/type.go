@@ -6,11 +6,16 @@ import (
 	"strings"
 
 	"github.com/dave/jennifer/jen"
+	"github.com/jmattheis/goverter/suggest"
 )
 
 // ThisVar is used as name for the reference to the converter interface.
 const ThisVar = "c"
 
+// ContextType is the type of context.Context parameters, they're always
+// handled as context.
+const ContextType = "context.Context"
+
 // Signature represents a signature for conversion.
 type Signature struct {
 	Source string
@@ -50,6 +55,8 @@ type Type struct {
 	FuncType      *types.Func
 	Chan          bool
 	ChanType      *types.Chan
+	TypeParam     bool
+	TypeParamType *types.TypeParam
 
 	enum *Enum
 }
@@ -127,6 +134,20 @@ func (t Type) findAllFields(path []string, name string, ignoreCase bool) (*Struc
 	return nil, matches
 }
 
+// fieldNames returns the names of all fields and methods usable as source.
+func (t Type) fieldNames() []string {
+	var names []string
+	for y := 0; y < t.StructType.NumFields(); y++ {
+		names = append(names, t.StructType.Field(y).Name())
+	}
+	if t.Named {
+		for y := 0; y < t.NamedType.NumMethods(); y++ {
+			names = append(names, t.NamedType.Method(y).Name())
+		}
+	}
+	return names
+}
+
 type FieldSources struct {
 	Path []string
 	Type *Type
@@ -135,12 +156,16 @@ type FieldSources struct {
 func FindExactField(source *Type, name string) (*SimpleStructField, error) {
 	exactMatch, _ := source.findAllFields(nil, name, false)
 	if exactMatch == nil {
-		return nil, fmt.Errorf("%q does not exist", name)
+		return nil, &NoMatchError{Field: name, Suggestions: suggest.Similar(name, source.fieldNames())}
 	}
 	return &SimpleStructField{Name: exactMatch.Path[0], Type: exactMatch.Type}, nil
 }
 
-type NoMatchError struct{ Field string }
+type NoMatchError struct {
+	Field string
+	// Suggestions contains similar field names of the source.
+	Suggestions []string
+}
 
 func (err *NoMatchError) Error() string {
 	return fmt.Sprintf("\"%s\" does not exist", err.Field)
@@ -170,7 +195,11 @@ func FindField(name string, ignoreCase bool, source *Type, additionalFieldSource
 	case 1:
 		return matches[0], nil
 	case 0:
-		return nil, &NoMatchError{Field: name}
+		candidates := source.fieldNames()
+		for _, source := range additionalFieldSources {
+			candidates = append(candidates, source.Type.fieldNames()...)
+		}
+		return nil, &NoMatchError{Field: name, Suggestions: suggest.Similar(name, candidates)}
 	default:
 		names := make([]string, 0, len(matches))
 		for _, m := range matches {
@@ -266,7 +295,8 @@ func applyTo(rt *Type, t types.Type) {
 		rt.Chan = true
 		rt.ChanType = value
 	case *types.TypeParam:
-		// ignore
+		rt.TypeParam = true
+		rt.TypeParamType = value
 	default:
 		panic("unknown types.Type " + t.String())
 	}
@@ -316,6 +346,9 @@ func (t *Type) asID(seeNamed, escapeReserved bool) string {
 	if t.Chan {
 		return "chan"
 	}
+	if t.TypeParam {
+		return t.TypeParamType.Obj().Name()
+	}
 	return "unknown"
 }
 